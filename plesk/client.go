@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 
@@ -19,57 +20,36 @@ type Client struct {
 }
 
 func (c *Client) Get(ctx context.Context, path string) ([]byte, diag.Diagnostics) {
-	url := fmt.Sprintf("https://%s:%s%s", c.Host, c.Port, path)
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, diag.Diagnostics{
-			{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("Failed to create GET request: %s", err),
-			},
-		}
+	resp, diags := c.doRequest(ctx, c.Client, http.MethodGet, path, nil, "")
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.Token)
-	req.Header.Set("Accept", "application/json")
+	return readResponseBody(http.MethodGet, resp)
+}
 
-	resp, err := c.Client.Do(req)
-	if err != nil {
-		return nil, diag.Diagnostics{
-			{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("GET request failed: %s", err),
-			},
-		}
+// GetIfExists behaves like Get but reports a 404 response as a missing
+// object instead of an error.
+func (c *Client) GetIfExists(ctx context.Context, path string) ([]byte, bool, diag.Diagnostics) {
+	resp, diags := c.doRequest(ctx, c.Client, http.MethodGet, path, nil, "")
+	if diags.HasError() {
+		return nil, false, diags
 	}
-	defer resp.Body.Close()
 
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, diag.Diagnostics{
-			{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("Failed to read GET response body: %s", err),
-			},
-		}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, false, nil
 	}
 
-	if resp.StatusCode >= 400 {
-		return nil, diag.Diagnostics{
-			{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("GET request returned HTTP %d: %s", resp.StatusCode, string(body)),
-			},
-		}
+	body, diags := readResponseBody(http.MethodGet, resp)
+	if diags.HasError() {
+		return nil, false, diags
 	}
 
-	return body, nil
+	return body, true, nil
 }
 
 func (c *Client) Post(ctx context.Context, path string, data interface{}) ([]byte, diag.Diagnostics) {
-	url := fmt.Sprintf("https://%s:%s%s", c.Host, c.Port, path)
-
 	jsonData, err := json.Marshal(data)
 	if err != nil {
 		return nil, diag.Diagnostics{
@@ -80,89 +60,129 @@ func (c *Client) Post(ctx context.Context, path string, data interface{}) ([]byt
 		}
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(jsonData))
-	if err != nil {
-		return nil, diag.Diagnostics{
-			{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("Failed to create POST request: %s", err),
-			},
-		}
+	resp, diags := c.doRequest(ctx, c.Client, http.MethodPost, path, bytes.NewReader(jsonData), "application/json")
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.Token)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
+	return readResponseBody(http.MethodPost, resp)
+}
 
-	resp, err := c.Client.Do(req)
+func (c *Client) Put(ctx context.Context, path string, data interface{}) ([]byte, diag.Diagnostics) {
+	jsonData, err := json.Marshal(data)
 	if err != nil {
 		return nil, diag.Diagnostics{
 			{
 				Severity: diag.Error,
-				Summary:  fmt.Sprintf("POST request failed: %s", err),
+				Summary:  fmt.Sprintf("Failed to marshal PUT data: %s", err),
 			},
 		}
 	}
-	defer resp.Body.Close()
 
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, diag.Diagnostics{
-			{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("Failed to read POST response body: %s", err),
-			},
-		}
+	resp, diags := c.doRequest(ctx, c.Client, http.MethodPut, path, bytes.NewReader(jsonData), "application/json")
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	if resp.StatusCode >= 400 {
-		return nil, diag.Diagnostics{
-			{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("POST request returned HTTP %d: %s", resp.StatusCode, string(body)),
-			},
-		}
+	return readResponseBody(http.MethodPut, resp)
+}
+
+func (c *Client) Delete(ctx context.Context, path string) diag.Diagnostics {
+	resp, diags := c.doRequest(ctx, c.Client, http.MethodDelete, path, nil, "")
+	if diags.HasError() {
+		return diags
 	}
 
-	return body, nil
+	_, diags = readResponseBody(http.MethodDelete, resp)
+	return diags
 }
 
-func (c *Client) Put(ctx context.Context, path string, data interface{}) ([]byte, diag.Diagnostics) {
-	url := fmt.Sprintf("https://%s:%s%s", c.Host, c.Port, path)
+// PostStream sends body as the raw request body of a POST request. Unlike
+// Post it does not buffer the body and is only bounded by ctx, so it suits
+// large uploads such as database dumps.
+func (c *Client) PostStream(ctx context.Context, path string, body io.Reader, contentType string) ([]byte, diag.Diagnostics) {
+	resp, diags := c.doRequest(ctx, c.transferClient(), http.MethodPost, path, body, contentType)
+	if diags.HasError() {
+		return nil, diags
+	}
 
-	jsonData, err := json.Marshal(data)
+	return readResponseBody(http.MethodPost, resp)
+}
+
+// GetStream copies the body of a GET response to w and returns the number
+// of bytes written. Like PostStream it is only bounded by ctx.
+func (c *Client) GetStream(ctx context.Context, path string, w io.Writer) (int64, diag.Diagnostics) {
+	resp, diags := c.doRequest(ctx, c.transferClient(), http.MethodGet, path, nil, "")
+	if diags.HasError() {
+		return 0, diags
+	}
+
+	if resp.StatusCode >= 400 {
+		_, diags := readResponseBody(http.MethodGet, resp)
+		return 0, diags
+	}
+	defer resp.Body.Close()
+
+	n, err := io.Copy(w, resp.Body)
 	if err != nil {
-		return nil, diag.Diagnostics{
+		return n, diag.Diagnostics{
 			{
 				Severity: diag.Error,
-				Summary:  fmt.Sprintf("Failed to marshal PUT data: %s", err),
+				Summary:  fmt.Sprintf("Failed to read GET response body: %s", err),
 			},
 		}
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(jsonData))
+	return n, nil
+}
+
+// transferClient returns a copy of the HTTP client without its overall
+// timeout, leaving long transfers to the deadline of the request context.
+func (c *Client) transferClient() *http.Client {
+	httpClient := *c.Client
+	httpClient.Timeout = 0
+
+	return &httpClient
+}
+
+// doRequest sends an authenticated API request using httpClient and returns
+// the response with its body still open. contentType is only set when
+// body is not nil.
+func (c *Client) doRequest(ctx context.Context, httpClient *http.Client, method, path string, body io.Reader, contentType string) (*http.Response, diag.Diagnostics) {
+	url := fmt.Sprintf("https://%s:%s%s", c.Host, c.Port, path)
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, diag.Diagnostics{
 			{
 				Severity: diag.Error,
-				Summary:  fmt.Sprintf("Failed to create PUT request: %s", err),
+				Summary:  fmt.Sprintf("Failed to create %s request: %s", method, err),
 			},
 		}
 	}
 
 	req.Header.Set("Authorization", "Bearer "+c.Token)
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
+	if body != nil && contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, diag.Diagnostics{
 			{
 				Severity: diag.Error,
-				Summary:  fmt.Sprintf("PUT request failed: %s", err),
+				Summary:  fmt.Sprintf("%s request failed: %s", method, err),
 			},
 		}
 	}
+
+	return resp, nil
+}
+
+// readResponseBody reads and closes the body of resp, turning an HTTP error
+// status into diagnostics.
+func readResponseBody(method string, resp *http.Response) ([]byte, diag.Diagnostics) {
 	defer resp.Body.Close()
 
 	body, err := ioutil.ReadAll(resp.Body)
@@ -170,7 +190,7 @@ func (c *Client) Put(ctx context.Context, path string, data interface{}) ([]byte
 		return nil, diag.Diagnostics{
 			{
 				Severity: diag.Error,
-				Summary:  fmt.Sprintf("Failed to read PUT response body: %s", err),
+				Summary:  fmt.Sprintf("Failed to read %s response body: %s", method, err),
 			},
 		}
 	}
@@ -179,50 +199,10 @@ func (c *Client) Put(ctx context.Context, path string, data interface{}) ([]byte
 		return nil, diag.Diagnostics{
 			{
 				Severity: diag.Error,
-				Summary:  fmt.Sprintf("PUT request returned HTTP %d: %s", resp.StatusCode, string(body)),
+				Summary:  fmt.Sprintf("%s request returned HTTP %d: %s", method, resp.StatusCode, string(body)),
 			},
 		}
 	}
 
 	return body, nil
 }
-
-func (c *Client) Delete(ctx context.Context, path string) diag.Diagnostics {
-	url := fmt.Sprintf("https://%s:%s%s", c.Host, c.Port, path)
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
-	if err != nil {
-		return diag.Diagnostics{
-			{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("Failed to create DELETE request: %s", err),
-			},
-		}
-	}
-
-	req.Header.Set("Authorization", "Bearer "+c.Token)
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := c.Client.Do(req)
-	if err != nil {
-		return diag.Diagnostics{
-			{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("DELETE request failed: %s", err),
-			},
-		}
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode >= 400 {
-		body, _ := ioutil.ReadAll(resp.Body)
-		return diag.Diagnostics{
-			{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("DELETE request returned HTTP %d: %s", resp.StatusCode, string(body)),
-			},
-		}
-	}
-
-	return nil
-}
@@ -0,0 +1,129 @@
+package plesk
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a Client talking to a TLS test server that runs
+// handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	srv := httptest.NewTLSServer(handler)
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &Client{Host: u.Hostname(), Port: u.Port(), Token: "token", Client: srv.Client()}
+}
+
+func TestClientGetIfExists(t *testing.T) {
+	cases := []struct {
+		name      string
+		status    int
+		body      string
+		wantFound bool
+		wantErr   bool
+	}{
+		{"found", http.StatusOK, `{"id":"1"}`, true, false},
+		{"not found", http.StatusNotFound, `{"error":"not found"}`, false, false},
+		{"server error", http.StatusInternalServerError, `boom`, false, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Authorization"); got != "Bearer token" {
+					t.Errorf("Authorization = %q", got)
+				}
+				w.WriteHeader(tc.status)
+				w.Write([]byte(tc.body))
+			})
+
+			body, found, diags := client.GetIfExists(context.Background(), "/api/v2/objects/1")
+			if found != tc.wantFound || diags.HasError() != tc.wantErr {
+				t.Fatalf("GetIfExists() found = %v, diags = %v", found, diags)
+			}
+			if found && string(body) != tc.body {
+				t.Errorf("body = %q, want %q", body, tc.body)
+			}
+		})
+	}
+}
+
+func TestClientGetReportsNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if _, diags := client.Get(context.Background(), "/api/v2/objects/1"); !diags.HasError() {
+		t.Error("Get() on a 404 response returned no error")
+	}
+}
+
+func TestClientStreamsIgnoreClientTimeout(t *testing.T) {
+	dump := strings.Repeat("INSERT INTO t VALUES (1);\n", 1000)
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// Outlast the client's timeout, which must not apply to streams.
+		time.Sleep(50 * time.Millisecond)
+
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(dump))
+		case http.MethodPost:
+			if got := r.Header.Get("Content-Type"); got != "application/sql" {
+				t.Errorf("Content-Type = %q", got)
+			}
+			body, _ := ioutil.ReadAll(r.Body)
+			if string(body) != dump {
+				t.Errorf("uploaded %d bytes, want %d", len(body), len(dump))
+			}
+			w.Write([]byte(`{}`))
+		}
+	})
+	client.Client.Timeout = 20 * time.Millisecond
+
+	var buf bytes.Buffer
+	n, diags := client.GetStream(context.Background(), "/api/v2/databases/1/export", &buf)
+	if diags.HasError() {
+		t.Fatalf("GetStream() diags = %v", diags)
+	}
+	if n != int64(len(dump)) || buf.String() != dump {
+		t.Errorf("GetStream() wrote %d bytes, want %d", n, len(dump))
+	}
+
+	if _, diags := client.PostStream(context.Background(), "/api/v2/databases/1/import", strings.NewReader(dump), "application/sql"); diags.HasError() {
+		t.Fatalf("PostStream() diags = %v", diags)
+	}
+
+	if _, diags := client.Get(context.Background(), "/api/v2/databases/1"); !diags.HasError() {
+		t.Error("Get() did not apply the client timeout")
+	}
+}
+
+func TestClientGetStreamReportsHTTPErrors(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("denied"))
+	})
+
+	var buf bytes.Buffer
+	if _, diags := client.GetStream(context.Background(), "/api/v2/databases/1/export", &buf); !diags.HasError() {
+		t.Error("GetStream() on a 403 response returned no error")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("GetStream() wrote the error body %q", buf.String())
+	}
+}
@@ -0,0 +1,126 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataSourceBackups lists the backup files of a subscription or of the server.
+func DataSourceBackups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBackupsRead,
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the subscription's domain. Omit to list server backups.",
+			},
+			"storage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"local", "ftp", "s3"}, false),
+				Description:  "Only list backups kept in this storage (local, ftp or s3).",
+			},
+			"backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Backup type (full or incremental).",
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Size of the backup in bytes.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBackupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	ownerID := d.Get("domain_id").(string)
+	if ownerID == "" {
+		ownerID = "server"
+	}
+
+	backupFiles, diags := listBackups(ctx, client, ownerID)
+	if diags.HasError() {
+		return diags
+	}
+
+	storage := d.Get("storage").(string)
+
+	backups := make([]map[string]interface{}, 0, len(backupFiles))
+	for _, backup := range backupFiles {
+		if storage != "" && backup.Storage != storage {
+			continue
+		}
+		backups = append(backups, map[string]interface{}{
+			"name":       backup.Name,
+			"storage":    backup.Storage,
+			"type":       backup.Type,
+			"created_at": backup.CreatedAt,
+			"size":       backup.Size,
+		})
+	}
+
+	d.SetId("plesk-backups-" + ownerID)
+	d.Set("backups", backups)
+
+	return nil
+}
+
+// backupFile mirrors an entry of GET {owner}/backups.
+type backupFile struct {
+	Name      string `json:"name"`
+	Storage   string `json:"storage"`
+	Type      string `json:"type"`
+	CreatedAt string `json:"created_at"`
+	Size      int    `json:"size"`
+}
+
+// listBackups returns the backup files of a subscription, or of the server
+// when ownerID is "server".
+func listBackups(ctx context.Context, client *Client, ownerID string) ([]backupFile, diag.Diagnostics) {
+	respBody, diags := client.Get(ctx, backupOwnerPath(ownerID)+"/backups")
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var backupsResp struct {
+		Backups []backupFile `json:"backups"`
+	}
+	if err := json.Unmarshal(respBody, &backupsResp); err != nil {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Failed to parse backups response",
+			Detail:   err.Error(),
+		}}
+	}
+
+	return backupsResp.Backups, nil
+}
@@ -0,0 +1,104 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataSourceDatabaseServers lists the database servers registered with
+// Plesk, optionally filtered by type.
+func DataSourceDatabaseServers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDatabaseServersRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(databaseServerTypes, false),
+				Description:  "Only list servers of this type (mysql, pgsql or mssql).",
+			},
+			"servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatabaseServersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, diags := client.Get(ctx, "/api/v2/dbservers")
+	if diags.HasError() {
+		return diags
+	}
+
+	var serversResp struct {
+		Servers []databaseServer `json:"servers"`
+	}
+	if err := json.Unmarshal(respBody, &serversResp); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Failed to parse database servers response",
+			Detail:   err.Error(),
+		}}
+	}
+
+	serverType := d.Get("type").(string)
+
+	servers := make([]map[string]interface{}, 0, len(serversResp.Servers))
+	for _, server := range serversResp.Servers {
+		if serverType != "" && server.Type != serverType {
+			continue
+		}
+		servers = append(servers, map[string]interface{}{
+			"id":      server.ID,
+			"type":    server.Type,
+			"host":    server.Host,
+			"port":    server.Port,
+			"default": server.Default,
+			"status":  server.Status,
+		})
+	}
+
+	if serverType != "" {
+		d.SetId("plesk-database-servers-" + serverType)
+	} else {
+		d.SetId("plesk-database-servers") // static ID for the data source instance
+	}
+	d.Set("servers", servers)
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourcePHPHandlers lists the PHP handlers installed on the server.
+func DataSourcePHPHandlers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePHPHandlersRead,
+		Schema: map[string]*schema.Schema{
+			"handlers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Handler type (e.g., fpm, fastcgi, cgi, module).",
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePHPHandlersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, diags := client.Get(ctx, "/api/v2/php-handlers")
+	if diags.HasError() {
+		return diags
+	}
+
+	var handlersResp struct {
+		Handlers []struct {
+			ID          string `json:"id"`
+			DisplayName string `json:"display_name"`
+			Version     string `json:"full_version"`
+			Type        string `json:"type"`
+			Enabled     bool   `json:"enabled"`
+		} `json:"handlers"`
+	}
+	if err := json.Unmarshal(respBody, &handlersResp); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Failed to parse PHP handlers response",
+			Detail:   err.Error(),
+		}}
+	}
+
+	handlers := make([]map[string]interface{}, 0, len(handlersResp.Handlers))
+	for _, handler := range handlersResp.Handlers {
+		handlers = append(handlers, map[string]interface{}{
+			"id":           handler.ID,
+			"display_name": handler.DisplayName,
+			"version":      handler.Version,
+			"type":         handler.Type,
+			"enabled":      handler.Enabled,
+		})
+	}
+
+	d.SetId("plesk-php-handlers") // static ID for the data source instance
+	d.Set("handlers", handlers)
+
+	return nil
+}
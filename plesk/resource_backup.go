@@ -0,0 +1,207 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceBackup creates an on-demand backup of a subscription, or of the
+// server when no domain is given. The backup runs on creation and waits for
+// the Plesk task to finish, so changing any argument creates a new backup.
+// Destroying the resource deletes the backup file. The resource ID is the
+// backup name.
+func ResourceBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBackupCreate,
+		ReadContext:   resourceBackupRead,
+		DeleteContext: resourceBackupDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the subscription's domain to back up. Omit to back up the server.",
+			},
+			"storage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "local",
+				ValidateFunc: validation.StringInSlice([]string{"local", "ftp", "s3"}, false),
+				Description:  "Storage the backup is written to (local, ftp or s3).",
+			},
+			"objects": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"web", "mail", "databases"}, false),
+				},
+				Description: "Object types to back up (web, mail, databases). Omit to back up everything.",
+			},
+			"incremental": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether to create an incremental backup based on the last full backup.",
+			},
+			"exclude_log_files": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether log files are left out of the backup.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Comment stored with the backup.",
+			},
+			"backup_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "Password to encrypt the backup with.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that create a new backup when changed.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the backup file.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Backup type (full or incremental).",
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the backup in bytes.",
+			},
+			"task_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the Plesk task that created the backup.",
+			},
+		},
+	}
+}
+
+func resourceBackupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	ownerID := d.Get("domain_id").(string)
+	if ownerID == "" {
+		ownerID = "server"
+	}
+
+	reqBody := map[string]interface{}{
+		"storage":           d.Get("storage").(string),
+		"incremental":       d.Get("incremental").(bool),
+		"exclude_log_files": d.Get("exclude_log_files").(bool),
+	}
+	if objects := expandStringSet(d.Get("objects").(*schema.Set)); len(objects) > 0 {
+		reqBody["objects"] = objects
+	}
+	if v, ok := d.GetOk("description"); ok {
+		reqBody["description"] = v.(string)
+	}
+	if v, ok := d.GetOk("backup_password"); ok {
+		reqBody["backup_password"] = v.(string)
+	}
+
+	respBody, diags := client.Post(ctx, backupOwnerPath(ownerID)+"/backups", reqBody)
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp struct {
+		TaskID string `json:"task_id"`
+		Name   string `json:"name"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse backup response: %s", err)
+	}
+	if resp.TaskID == "" || resp.Name == "" {
+		return diag.Errorf("backup response did not include a task_id and a name: %s", string(respBody))
+	}
+
+	if _, diags := waitForTask(ctx, client, resp.TaskID, fmt.Sprintf("backup %s", resp.Name), d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(resp.Name)
+	d.Set("task_id", resp.TaskID)
+
+	return resourceBackupRead(ctx, d, m)
+}
+
+func resourceBackupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	ownerID := d.Get("domain_id").(string)
+	if ownerID == "" {
+		ownerID = "server"
+	}
+
+	backups, diags := listBackups(ctx, client, ownerID)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, backup := range backups {
+		if backup.Name != d.Id() || backup.Storage != d.Get("storage").(string) {
+			continue
+		}
+
+		d.Set("name", backup.Name)
+		d.Set("type", backup.Type)
+		d.Set("created_at", backup.CreatedAt)
+		d.Set("size", backup.Size)
+		return nil
+	}
+
+	// The backup was removed outside Terraform, e.g. by the retention of a
+	// backup schedule.
+	d.SetId("")
+	return nil
+}
+
+func resourceBackupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	ownerID := d.Get("domain_id").(string)
+	if ownerID == "" {
+		ownerID = "server"
+	}
+
+	path := fmt.Sprintf("%s/backups/%s?storage=%s", backupOwnerPath(ownerID), url.PathEscape(d.Id()), url.QueryEscape(d.Get("storage").(string)))
+	return client.Delete(ctx, path)
+}
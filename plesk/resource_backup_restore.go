@@ -0,0 +1,170 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceBackupRestore restores a backup into a subscription, or into the
+// server when no domain is given. The restore runs on creation and waits for
+// the Plesk task to finish, so changing any argument restores again;
+// destroying the resource does not undo the restore.
+func ResourceBackupRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBackupRestoreCreate,
+		ReadContext:   resourceBackupRestoreRead,
+		DeleteContext: resourceBackupRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the subscription's domain to restore into. Omit to restore a server backup.",
+			},
+			"backup_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the backup file to restore. See the plesk_backups data source.",
+			},
+			"storage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "local",
+				ValidateFunc: validation.StringInSlice([]string{"local", "ftp", "s3"}, false),
+				Description:  "Storage the backup is kept in (local, ftp or s3).",
+			},
+			"objects": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"web", "mail", "databases"}, false),
+				},
+				Description: "Object types to restore (web, mail, databases). Omit to restore everything in the backup.",
+			},
+			"conflict_resolution": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "overwrite",
+				ValidateFunc: validation.StringInSlice([]string{"overwrite", "skip"}, false),
+				Description:  "How objects that already exist are handled: overwrite them or skip them.",
+			},
+			"backup_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "Password the backup was encrypted with.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that restore the backup again when changed.",
+			},
+			"task_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the Plesk task that performed the restore.",
+			},
+		},
+	}
+}
+
+func resourceBackupRestoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	ownerID := d.Get("domain_id").(string)
+	if ownerID == "" {
+		ownerID = "server"
+	}
+	backupName := d.Get("backup_name").(string)
+
+	reqBody := map[string]interface{}{
+		"storage":             d.Get("storage").(string),
+		"conflict_resolution": d.Get("conflict_resolution").(string),
+	}
+	if objects := expandStringSet(d.Get("objects").(*schema.Set)); len(objects) > 0 {
+		reqBody["objects"] = objects
+	}
+	if v, ok := d.GetOk("backup_password"); ok {
+		reqBody["backup_password"] = v.(string)
+	}
+
+	path := fmt.Sprintf("%s/backups/%s/restore", backupOwnerPath(ownerID), url.PathEscape(backupName))
+	respBody, diags := client.Post(ctx, path, reqBody)
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp struct {
+		TaskID string `json:"task_id"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse backup restore response: %s", err)
+	}
+	if resp.TaskID == "" {
+		return diag.Errorf("backup restore response did not include a task_id: %s", string(respBody))
+	}
+
+	task, diags := waitForTask(ctx, client, resp.TaskID, fmt.Sprintf("restore of backup %s", backupName), d.Timeout(schema.TimeoutCreate))
+	if task != nil {
+		diags = append(diags, backupRestoreConflictDiagnostics(backupName, task)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(resp.TaskID)
+	d.Set("task_id", resp.TaskID)
+
+	return diags
+}
+
+func resourceBackupRestoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Restoring a backup is a one-off action with nothing to read back.
+	return nil
+}
+
+func resourceBackupRestoreDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// backupRestoreConflictDiagnostics turns the conflicts Plesk reported while
+// restoring into diagnostics: errors when the restore failed, warnings when
+// it completed by resolving them.
+func backupRestoreConflictDiagnostics(backupName string, task *pleskTask) diag.Diagnostics {
+	severity := diag.Warning
+	if task.Status == "failed" {
+		severity = diag.Error
+	}
+
+	var diags diag.Diagnostics
+	for _, conflict := range task.Conflicts {
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("Restore conflict on %s %s", conflict.Type, conflict.Object),
+			Detail:   fmt.Sprintf("Restoring backup %s: %s", backupName, conflict.Message),
+		})
+	}
+
+	return diags
+}
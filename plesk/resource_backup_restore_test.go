@@ -0,0 +1,30 @@
+package plesk
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceBackupRestoreCreateWithoutTaskID(t *testing.T) {
+	for _, body := range []string{`{}`, `{"task_id":""}`} {
+		t.Run(body, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if strings.HasPrefix(r.URL.Path, "/api/v2/tasks/") {
+					t.Errorf("unexpected task poll %s", r.URL.Path)
+				}
+				w.Write([]byte(body))
+			})
+
+			d := schema.TestResourceDataRaw(t, ResourceBackupRestore().Schema, map[string]interface{}{
+				"backup_name": "backup_2026.tar",
+			})
+			if diags := resourceBackupRestoreCreate(context.Background(), d, client); !diags.HasError() {
+				t.Fatal("resourceBackupRestoreCreate() returned no error")
+			}
+		})
+	}
+}
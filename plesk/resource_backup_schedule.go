@@ -0,0 +1,199 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceBackupSchedule defines the scheduled backup of a subscription, or
+// of the whole server when no domain is given. The resource ID is the
+// domain ID or "server".
+func ResourceBackupSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBackupScheduleCreate,
+		ReadContext:   resourceBackupScheduleRead,
+		UpdateContext: resourceBackupScheduleUpdate,
+		DeleteContext: resourceBackupScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the subscription's domain to back up. Omit to schedule the server backup.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the scheduled backup is active.",
+			},
+			"frequency": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "daily",
+				ValidateFunc: validation.StringInSlice([]string{"hourly", "daily", "weekly", "monthly"}, false),
+				Description:  "How often the backup runs (hourly, daily, weekly or monthly).",
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "01:00",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`), "must be a time in HH:MM format"),
+				Description:  "Time of day the backup starts (HH:MM, server time).",
+			},
+			"retention": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      7,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of backups to keep. 0 keeps all backups.",
+			},
+			"storage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "local",
+				ValidateFunc: validation.StringInSlice([]string{"local", "remote", "both"}, false),
+				Description:  "Where backups are stored: local (server repository), remote (the configured FTP or S3 storage) or both.",
+			},
+			"incremental": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to create incremental backups between full backups.",
+			},
+			"full_backup_frequency": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "weekly",
+				ValidateFunc: validation.StringInSlice([]string{"weekly", "monthly"}, false),
+				Description:  "How often a full backup is created when incremental backups are enabled (weekly or monthly).",
+			},
+			"exclude_log_files": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether log files are left out of the backup.",
+			},
+			"exclude_files": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Paths or patterns of files left out of the backup (e.g., httpdocs/cache/*).",
+			},
+		},
+	}
+}
+
+// backupScheduleBody mirrors the body of the backup schedule endpoints.
+type backupScheduleBody struct {
+	Enabled             bool     `json:"enabled"`
+	Frequency           string   `json:"frequency"`
+	StartTime           string   `json:"start_time"`
+	Retention           int      `json:"retention"`
+	Storage             string   `json:"storage"`
+	Incremental         bool     `json:"incremental"`
+	FullBackupFrequency string   `json:"full_backup_frequency"`
+	ExcludeLogFiles     bool     `json:"exclude_log_files"`
+	ExcludeFiles        []string `json:"exclude_files"`
+}
+
+func resourceBackupScheduleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if v, ok := d.GetOk("domain_id"); ok {
+		d.SetId(v.(string))
+	} else {
+		d.SetId("server")
+	}
+
+	if diags := resourceBackupSchedulePut(ctx, d, m); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceBackupScheduleRead(ctx, d, m)
+}
+
+func resourceBackupScheduleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, found, diags := client.GetIfExists(ctx, backupOwnerPath(d.Id())+"/backup-schedule")
+	if diags.HasError() {
+		return diags
+	}
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	var resp backupScheduleBody
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse backup schedule response: %s", err)
+	}
+
+	if d.Id() != "server" {
+		d.Set("domain_id", d.Id())
+	}
+	d.Set("enabled", resp.Enabled)
+	d.Set("frequency", resp.Frequency)
+	d.Set("start_time", resp.StartTime)
+	d.Set("retention", resp.Retention)
+	d.Set("storage", resp.Storage)
+	d.Set("incremental", resp.Incremental)
+	d.Set("full_backup_frequency", resp.FullBackupFrequency)
+	d.Set("exclude_log_files", resp.ExcludeLogFiles)
+	d.Set("exclude_files", resp.ExcludeFiles)
+
+	return nil
+}
+
+func resourceBackupScheduleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := resourceBackupSchedulePut(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return resourceBackupScheduleRead(ctx, d, m)
+}
+
+func resourceBackupScheduleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	return client.Delete(ctx, backupOwnerPath(d.Id())+"/backup-schedule")
+}
+
+func resourceBackupSchedulePut(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	reqBody := backupScheduleBody{
+		Enabled:             d.Get("enabled").(bool),
+		Frequency:           d.Get("frequency").(string),
+		StartTime:           d.Get("start_time").(string),
+		Retention:           d.Get("retention").(int),
+		Storage:             d.Get("storage").(string),
+		Incremental:         d.Get("incremental").(bool),
+		FullBackupFrequency: d.Get("full_backup_frequency").(string),
+		ExcludeLogFiles:     d.Get("exclude_log_files").(bool),
+		ExcludeFiles:        expandStringSet(d.Get("exclude_files").(*schema.Set)),
+	}
+
+	_, diags := client.Put(ctx, backupOwnerPath(d.Id())+"/backup-schedule", reqBody)
+	return diags
+}
+
+// backupOwnerPath returns the API path of the object owning backup
+// settings: the server for "server", otherwise the domain with that ID.
+func backupOwnerPath(ownerID string) string {
+	if ownerID == "server" || ownerID == "" {
+		return "/api/v2/server"
+	}
+
+	return fmt.Sprintf("/api/v2/domains/%s", ownerID)
+}
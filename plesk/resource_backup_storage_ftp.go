@@ -0,0 +1,168 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceBackupStorageFTP defines the FTP remote backup storage of a
+// subscription or of the server. The resource ID is the domain ID or "server".
+func ResourceBackupStorageFTP() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBackupStorageFTPCreate,
+		ReadContext:   resourceBackupStorageFTPRead,
+		UpdateContext: resourceBackupStorageFTPUpdate,
+		DeleteContext: resourceBackupStorageFTPDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the subscription's domain. Omit to configure the server storage.",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Host name or IP address of the FTP server.",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      21,
+				ValidateFunc: validation.IsPortNumber,
+				Description:  "Port of the FTP server.",
+			},
+			"login": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "FTP login.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "FTP password.",
+			},
+			"directory": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/",
+				Description: "Directory on the FTP server that stores the backups.",
+			},
+			"passive_mode": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to use passive FTP mode.",
+			},
+			"use_ftps": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to connect using FTPS.",
+			},
+			"backup_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password used to encrypt backups stored on the FTP server.",
+			},
+		},
+	}
+}
+
+func resourceBackupStorageFTPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if v, ok := d.GetOk("domain_id"); ok {
+		d.SetId(v.(string))
+	} else {
+		d.SetId("server")
+	}
+
+	if diags := resourceBackupStorageFTPPut(ctx, d, m); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceBackupStorageFTPRead(ctx, d, m)
+}
+
+func resourceBackupStorageFTPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, found, diags := client.GetIfExists(ctx, backupOwnerPath(d.Id())+"/backup-storage/ftp")
+	if diags.HasError() {
+		return diags
+	}
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	var resp struct {
+		Host        string `json:"host"`
+		Port        int    `json:"port"`
+		Login       string `json:"login"`
+		Directory   string `json:"directory"`
+		PassiveMode bool   `json:"passive_mode"`
+		UseFTPS     bool   `json:"use_ftps"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse FTP backup storage response: %s", err)
+	}
+
+	if d.Id() != "server" {
+		d.Set("domain_id", d.Id())
+	}
+	d.Set("host", resp.Host)
+	d.Set("port", resp.Port)
+	d.Set("login", resp.Login)
+	d.Set("directory", resp.Directory)
+	d.Set("passive_mode", resp.PassiveMode)
+	d.Set("use_ftps", resp.UseFTPS)
+	// Note: password and backup_password are sensitive, generally not retrievable, so do not set
+
+	return nil
+}
+
+func resourceBackupStorageFTPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := resourceBackupStorageFTPPut(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return resourceBackupStorageFTPRead(ctx, d, m)
+}
+
+func resourceBackupStorageFTPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	return client.Delete(ctx, backupOwnerPath(d.Id())+"/backup-storage/ftp")
+}
+
+func resourceBackupStorageFTPPut(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	reqBody := map[string]interface{}{
+		"host":         d.Get("host").(string),
+		"port":         d.Get("port").(int),
+		"login":        d.Get("login").(string),
+		"password":     d.Get("password").(string),
+		"directory":    d.Get("directory").(string),
+		"passive_mode": d.Get("passive_mode").(bool),
+		"use_ftps":     d.Get("use_ftps").(bool),
+	}
+
+	if v, ok := d.GetOk("backup_password"); ok {
+		reqBody["backup_password"] = v.(string)
+	}
+
+	_, diags := client.Put(ctx, backupOwnerPath(d.Id())+"/backup-storage/ftp", reqBody)
+	return diags
+}
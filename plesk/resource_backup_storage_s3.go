@@ -0,0 +1,161 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceBackupStorageS3 defines the Amazon S3 (or S3-compatible) remote
+// backup storage of a subscription or of the server. The resource ID is the
+// domain ID or "server".
+func ResourceBackupStorageS3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBackupStorageS3Create,
+		ReadContext:   resourceBackupStorageS3Read,
+		UpdateContext: resourceBackupStorageS3Update,
+		DeleteContext: resourceBackupStorageS3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the subscription's domain. Omit to configure the server storage.",
+			},
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the bucket that stores the backups.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "us-east-1",
+				Description: "Region of the bucket.",
+			},
+			"endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+				Description:  "Endpoint of an S3-compatible service. Omit to use Amazon S3.",
+			},
+			"access_key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Access key ID.",
+			},
+			"secret_access_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Secret access key.",
+			},
+			"directory": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/",
+				Description: "Path inside the bucket that stores the backups.",
+			},
+			"backup_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password used to encrypt backups stored in the bucket.",
+			},
+		},
+	}
+}
+
+func resourceBackupStorageS3Create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if v, ok := d.GetOk("domain_id"); ok {
+		d.SetId(v.(string))
+	} else {
+		d.SetId("server")
+	}
+
+	if diags := resourceBackupStorageS3Put(ctx, d, m); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceBackupStorageS3Read(ctx, d, m)
+}
+
+func resourceBackupStorageS3Read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, found, diags := client.GetIfExists(ctx, backupOwnerPath(d.Id())+"/backup-storage/s3")
+	if diags.HasError() {
+		return diags
+	}
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	var resp struct {
+		Bucket      string `json:"bucket"`
+		Region      string `json:"region"`
+		Endpoint    string `json:"endpoint,omitempty"`
+		AccessKeyID string `json:"access_key_id"`
+		Directory   string `json:"directory"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse S3 backup storage response: %s", err)
+	}
+
+	if d.Id() != "server" {
+		d.Set("domain_id", d.Id())
+	}
+	d.Set("bucket", resp.Bucket)
+	d.Set("region", resp.Region)
+	d.Set("endpoint", resp.Endpoint)
+	d.Set("access_key_id", resp.AccessKeyID)
+	d.Set("directory", resp.Directory)
+	// Note: secret_access_key and backup_password are sensitive, generally not retrievable, so do not set
+
+	return nil
+}
+
+func resourceBackupStorageS3Update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := resourceBackupStorageS3Put(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return resourceBackupStorageS3Read(ctx, d, m)
+}
+
+func resourceBackupStorageS3Delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	return client.Delete(ctx, backupOwnerPath(d.Id())+"/backup-storage/s3")
+}
+
+func resourceBackupStorageS3Put(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	reqBody := map[string]interface{}{
+		"bucket":            d.Get("bucket").(string),
+		"region":            d.Get("region").(string),
+		"access_key_id":     d.Get("access_key_id").(string),
+		"secret_access_key": d.Get("secret_access_key").(string),
+		"directory":         d.Get("directory").(string),
+	}
+
+	if v, ok := d.GetOk("endpoint"); ok {
+		reqBody["endpoint"] = v.(string)
+	}
+	if v, ok := d.GetOk("backup_password"); ok {
+		reqBody["backup_password"] = v.(string)
+	}
+
+	_, diags := client.Put(ctx, backupOwnerPath(d.Id())+"/backup-storage/s3", reqBody)
+	return diags
+}
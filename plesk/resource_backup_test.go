@@ -0,0 +1,41 @@
+package plesk
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceBackupCreateIncompleteResponse(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+	}{
+		{"empty response", `{}`},
+		{"no task ID", `{"name":"backup_2026.tar"}`},
+		{"no name", `{"task_id":"42"}`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if strings.HasPrefix(r.URL.Path, "/api/v2/tasks/") {
+					t.Errorf("unexpected task poll %s", r.URL.Path)
+				}
+				w.Write([]byte(tc.body))
+			})
+
+			d := schema.TestResourceDataRaw(t, ResourceBackup().Schema, map[string]interface{}{})
+			diags := resourceBackupCreate(context.Background(), d, client)
+			if !diags.HasError() {
+				t.Fatal("resourceBackupCreate() returned no error")
+			}
+			if d.Id() != "" {
+				t.Errorf("ID = %q, want it unset", d.Id())
+			}
+		})
+	}
+}
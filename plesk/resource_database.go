@@ -34,7 +34,7 @@ func ResourceDatabase() *schema.Resource {
             "server_id": {
                 Type:        schema.TypeInt,
                 Optional:    true,
-                Description: "ID of the database server to use (optional).",
+                Description: "ID of the database server to use (optional). See plesk_database_server and the plesk_database_servers data source.",
             },
         },
     }
@@ -0,0 +1,130 @@
+package plesk
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceDatabaseExport exports an SQL dump of a database to a local file.
+// The export runs on creation, so changing triggers exports again; the dump
+// is also exported again when the file is removed or modified. Destroying
+// the resource leaves the file in place.
+func ResourceDatabaseExport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDatabaseExportCreate,
+		ReadContext:   resourceDatabaseExportRead,
+		DeleteContext: resourceDatabaseExportDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the database to export.",
+			},
+			"output_path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Local path the SQL dump is written to.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that export the database again when changed.",
+			},
+			"sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 hash of the exported dump.",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the exported dump in bytes.",
+			},
+		},
+	}
+}
+
+func resourceDatabaseExportCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	databaseID := d.Get("database_id").(string)
+	outputPath := d.Get("output_path").(string)
+
+	// Write to a temporary file next to the output so a failed export never
+	// leaves a truncated dump behind.
+	f, err := ioutil.TempFile(filepath.Dir(outputPath), ".plesk-export-*")
+	if err != nil {
+		return diag.Errorf("failed to create database dump file: %s", err)
+	}
+	defer os.Remove(f.Name())
+
+	hasher := sha256.New()
+	size, diags := client.GetStream(ctx, fmt.Sprintf("/api/v2/databases/%s/export", databaseID), io.MultiWriter(f, hasher))
+	if err := f.Close(); err != nil && !diags.HasError() {
+		return diag.Errorf("failed to write database dump: %s", err)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := os.Rename(f.Name(), outputPath); err != nil {
+		return diag.Errorf("failed to write database dump: %s", err)
+	}
+
+	hash := hex.EncodeToString(hasher.Sum(nil))
+
+	d.SetId(fmt.Sprintf("%s/%s", databaseID, hash))
+	d.Set("sha256", hash)
+	d.Set("size", size)
+
+	return nil
+}
+
+func resourceDatabaseExportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The export cannot be read back from Plesk; check that the local dump
+	// is still the one that was exported.
+	f, err := os.Open(d.Get("output_path").(string))
+	if os.IsNotExist(err) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to read database dump: %s", err)
+	}
+	defer f.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return diag.Errorf("failed to read database dump: %s", err)
+	}
+
+	if hex.EncodeToString(hasher.Sum(nil)) != d.Get("sha256").(string) {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceDatabaseExportDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,160 @@
+package plesk
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceDatabaseImport loads an SQL dump into a database once. The import
+// is keyed on the SHA-256 hash of the dump, so a changed dump is imported
+// again while an unchanged one is left alone. Destroying the resource does
+// not remove the imported data. The dump is streamed to Plesk, so its size
+// is bounded by the create timeout rather than by memory.
+func ResourceDatabaseImport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDatabaseImportCreate,
+		ReadContext:   resourceDatabaseImportRead,
+		DeleteContext: resourceDatabaseImportDelete,
+		CustomizeDiff: resourceDatabaseImportCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the database to import the dump into.",
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"source", "content"},
+				Description:  "Path of a local SQL dump file.",
+			},
+			"content": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"source", "content"},
+				Description:  "SQL dump content.",
+			},
+			"sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "SHA-256 hash of the imported dump.",
+			},
+		},
+	}
+}
+
+func resourceDatabaseImportCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	dump, err := openDatabaseImportDump(d.Get("source").(string), d.Get("content").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer dump.Close()
+
+	// Hash the dump while it is uploaded instead of reading it twice.
+	hasher := sha256.New()
+	body := io.TeeReader(dump, hasher)
+
+	databaseID := d.Get("database_id").(string)
+	path := fmt.Sprintf("/api/v2/databases/%s/import?format=sql", databaseID)
+	if _, diags := client.PostStream(ctx, path, body, "application/sql"); diags.HasError() {
+		return diags
+	}
+
+	hash := hex.EncodeToString(hasher.Sum(nil))
+	d.Set("sha256", hash)
+	d.SetId(fmt.Sprintf("%s/%s", databaseID, hash))
+
+	return nil
+}
+
+func resourceDatabaseImportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	// The import itself cannot be read back; only drop it from state when
+	// the target database is gone.
+	_, found, diags := client.GetIfExists(ctx, fmt.Sprintf("/api/v2/databases/%s", d.Get("database_id").(string)))
+	if diags.HasError() {
+		return diags
+	}
+	if !found {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceDatabaseImportDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func resourceDatabaseImportCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("source") || !d.NewValueKnown("content") {
+		return d.SetNewComputed("sha256")
+	}
+
+	hash, err := databaseImportDumpHash(d.Get("source").(string), d.Get("content").(string))
+	if err != nil {
+		return err
+	}
+
+	if hash != d.Get("sha256").(string) {
+		return d.SetNew("sha256", hash)
+	}
+
+	return nil
+}
+
+// openDatabaseImportDump opens the local file at source, or returns content
+// when no source is given.
+func openDatabaseImportDump(source, content string) (io.ReadCloser, error) {
+	if source == "" {
+		return ioutil.NopCloser(strings.NewReader(content)), nil
+	}
+
+	f, err := os.Open(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SQL dump %s: %s", source, err)
+	}
+
+	return f, nil
+}
+
+// databaseImportDumpHash returns the hex SHA-256 hash of the dump without
+// loading it into memory.
+func databaseImportDumpHash(source, content string) (string, error) {
+	dump, err := openDatabaseImportDump(source, content)
+	if err != nil {
+		return "", err
+	}
+	defer dump.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, dump); err != nil {
+		return "", fmt.Errorf("failed to read SQL dump %s: %s", source, err)
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
@@ -0,0 +1,186 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// databaseServerTypes lists the database server types Plesk can register.
+var databaseServerTypes = []string{"mysql", "pgsql", "mssql"}
+
+// ResourceDatabaseServer registers a database server with Plesk.
+func ResourceDatabaseServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDatabaseServerCreate,
+		ReadContext:   resourceDatabaseServerRead,
+		UpdateContext: resourceDatabaseServerUpdate,
+		DeleteContext: resourceDatabaseServerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(databaseServerTypes, false),
+				Description:  "The database server type (mysql, pgsql or mssql).",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Host name or IP address of the database server.",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsPortNumber,
+				Description:  "Port of the database server. Defaults to the standard port of the type.",
+			},
+			"admin_login": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Login of the database server administrator.",
+			},
+			"admin_password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password of the database server administrator.",
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether new databases of this type are created on this server by default.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Connection status of the database server as reported by Plesk.",
+			},
+		},
+	}
+}
+
+// databaseServer mirrors a database server as returned by /api/v2/dbservers.
+type databaseServer struct {
+	ID         int    `json:"id"`
+	Type       string `json:"type"`
+	Host       string `json:"host"`
+	Port       int    `json:"port"`
+	AdminLogin string `json:"admin_login"`
+	Default    bool   `json:"default"`
+	Status     string `json:"status"`
+}
+
+func resourceDatabaseServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	payload := map[string]interface{}{
+		"type":           d.Get("type").(string),
+		"host":           d.Get("host").(string),
+		"admin_login":    d.Get("admin_login").(string),
+		"admin_password": d.Get("admin_password").(string),
+		"default":        d.Get("default").(bool),
+	}
+
+	if v, ok := d.GetOk("port"); ok {
+		payload["port"] = v.(int)
+	}
+
+	respBody, diags := client.Post(ctx, "/api/v2/dbservers", payload)
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp struct {
+		ID int `json:"id"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Failed to parse response",
+			Detail:   err.Error(),
+		}}
+	}
+
+	d.SetId(strconv.Itoa(resp.ID))
+	return resourceDatabaseServerRead(ctx, d, m)
+}
+
+func resourceDatabaseServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, found, diags := client.GetIfExists(ctx, fmt.Sprintf("/api/v2/dbservers/%s", d.Id()))
+	if diags.HasError() {
+		return diags
+	}
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	var server databaseServer
+	if err := json.Unmarshal(respBody, &server); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Failed to parse response",
+			Detail:   err.Error(),
+		}}
+	}
+
+	d.Set("type", server.Type)
+	d.Set("host", server.Host)
+	d.Set("port", server.Port)
+	d.Set("admin_login", server.AdminLogin)
+	d.Set("default", server.Default)
+	d.Set("status", server.Status)
+	// Note: admin_password is sensitive, generally not retrievable, so do not set
+
+	return nil
+}
+
+func resourceDatabaseServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	payload := map[string]interface{}{}
+
+	if d.HasChange("admin_login") {
+		payload["admin_login"] = d.Get("admin_login").(string)
+	}
+
+	if d.HasChange("admin_password") {
+		payload["admin_password"] = d.Get("admin_password").(string)
+	}
+
+	if d.HasChange("default") {
+		payload["default"] = d.Get("default").(bool)
+	}
+
+	if len(payload) == 0 {
+		return nil
+	}
+
+	if _, diags := client.Put(ctx, fmt.Sprintf("/api/v2/dbservers/%s", d.Id()), payload); diags.HasError() {
+		return diags
+	}
+
+	return resourceDatabaseServerRead(ctx, d, m)
+}
+
+func resourceDatabaseServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	return client.Delete(ctx, fmt.Sprintf("/api/v2/dbservers/%s", d.Id()))
+}
@@ -32,8 +32,36 @@ func ResourceDatabaseUser() *schema.Resource {
             },
             "database_id": {
                 Type:     schema.TypeString,
-                Required: true,
-                Description: "ID of the database the user belongs to.",
+                Optional: true,
+                Description: "ID of the database the user belongs to. Omit when any_database is set.",
+            },
+            "any_database": {
+                Type:        schema.TypeBool,
+                Optional:    true,
+                Default:     false,
+                ForceNew:    true,
+                Description: "Whether the user can access any database within the subscription instead of a single one.",
+            },
+            "domain_id": {
+                Type:        schema.TypeString,
+                Optional:    true,
+                Computed:    true,
+                ForceNew:    true,
+                Description: "ID of the subscription's domain. Required when any_database is set.",
+            },
+            "role": {
+                Type:         schema.TypeString,
+                Optional:     true,
+                Computed:     true,
+                ValidateFunc: validation.StringInSlice([]string{"read-write", "read-only", "write-only"}, false),
+                Description:  "Access level of the user (read-write, read-only or write-only). Plesk creates read-write users by default. Omit to leave the current role untouched.",
+            },
+            "remote_access_hosts": {
+                Type:        schema.TypeSet,
+                Optional:    true,
+                Computed:    true,
+                Elem:        &schema.Schema{Type: schema.TypeString},
+                Description: "Host patterns the user may connect from (e.g., % or 10.0.0.%). Omit to leave the current hosts untouched.",
             },
         },
     }
@@ -43,9 +71,31 @@ func resourceDatabaseUserCreate(ctx context.Context, d *schema.ResourceData, m i
     client := m.(*Client)
 
     payload := map[string]interface{}{
-        "username":    d.Get("username").(string),
-        "password":    d.Get("password").(string),
-        "database_id": d.Get("database_id").(string),
+        "username": d.Get("username").(string),
+        "password": d.Get("password").(string),
+    }
+
+    if v, ok := d.GetOk("role"); ok {
+        payload["role"] = v.(string)
+    }
+    if v, ok := d.GetOk("remote_access_hosts"); ok {
+        payload["remote_access_hosts"] = expandStringSet(v.(*schema.Set))
+    }
+
+    databaseID := d.Get("database_id").(string)
+    domainID := d.Get("domain_id").(string)
+
+    if d.Get("any_database").(bool) {
+        if databaseID != "" || domainID == "" {
+            return diag.Errorf("any_database requires domain_id and cannot be combined with database_id")
+        }
+        payload["domain_id"] = domainID
+        payload["any_database"] = true
+    } else {
+        if databaseID == "" {
+            return diag.Errorf("database_id is required unless any_database is set")
+        }
+        payload["database_id"] = databaseID
     }
 
     respBody, diags := client.Post(ctx, "/api/v2/dbusers", payload)
@@ -77,9 +127,13 @@ func resourceDatabaseUserRead(ctx context.Context, d *schema.ResourceData, m int
     }
 
     var user struct {
-        ID         string `json:"id"`
-        Username   string `json:"username"`
-        DatabaseID string `json:"database_id"`
+        ID                string   `json:"id"`
+        Username          string   `json:"username"`
+        DatabaseID        string   `json:"database_id"`
+        DomainID          string   `json:"domain_id"`
+        AnyDatabase       bool     `json:"any_database"`
+        Role              string   `json:"role"`
+        RemoteAccessHosts []string `json:"remote_access_hosts"`
     }
     if err := json.Unmarshal(respBody, &user); err != nil {
         return diag.Diagnostics{{
@@ -91,6 +145,10 @@ func resourceDatabaseUserRead(ctx context.Context, d *schema.ResourceData, m int
 
     d.Set("username", user.Username)
     d.Set("database_id", user.DatabaseID)
+    d.Set("domain_id", user.DomainID)
+    d.Set("any_database", user.AnyDatabase)
+    d.Set("role", user.Role)
+    d.Set("remote_access_hosts", user.RemoteAccessHosts)
     // Note: password is sensitive, generally not retrievable, so do not set
 
     return nil
@@ -105,6 +163,18 @@ func resourceDatabaseUserUpdate(ctx context.Context, d *schema.ResourceData, m i
         payload["password"] = d.Get("password").(string)
     }
 
+    if d.HasChange("database_id") {
+        payload["database_id"] = d.Get("database_id").(string)
+    }
+
+    if d.HasChange("role") {
+        payload["role"] = d.Get("role").(string)
+    }
+
+    if d.HasChange("remote_access_hosts") {
+        payload["remote_access_hosts"] = expandStringSet(d.Get("remote_access_hosts").(*schema.Set))
+    }
+
     if len(payload) == 0 {
         return nil
     }
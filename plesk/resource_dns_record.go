@@ -4,12 +4,26 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// dnsRecordTypes lists the DNS record types supported by Plesk.
+var dnsRecordTypes = []string{"A", "AAAA", "CNAME", "MX", "TXT", "NS", "SRV", "PTR", "CAA", "TLSA", "DS", "DNAME"}
+
+// txtStringMaxLength is the maximum length of a single character-string in a TXT record.
+const txtStringMaxLength = 255
+
+var txtStringPattern = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"`)
+
 // ResourceDnsRecord defines the DNS record resource schema and CRUD operations.
 func ResourceDnsRecord() *schema.Resource {
 	return &schema.Resource{
@@ -17,6 +31,7 @@ func ResourceDnsRecord() *schema.Resource {
 		ReadContext:   resourceDnsRecordRead,
 		UpdateContext: resourceDnsRecordUpdate,
 		DeleteContext: resourceDnsRecordDelete,
+		CustomizeDiff: resourceDnsRecordCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -30,8 +45,8 @@ func ResourceDnsRecord() *schema.Resource {
 			"type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				Description:  "DNS record type (e.g., A, AAAA, CNAME, MX, TXT, NS, SRV, PTR).",
-				ValidateFunc: validation.StringInSlice([]string{"A", "AAAA", "CNAME", "MX", "TXT", "NS", "SRV", "PTR"}, false),
+				Description:  "DNS record type (e.g., A, AAAA, CNAME, MX, TXT, NS, SRV, PTR, CAA, TLSA, DS, DNAME).",
+				ValidateFunc: validation.StringInSlice(dnsRecordTypes, false),
 			},
 			"host": {
 				Type:        schema.TypeString,
@@ -40,8 +55,9 @@ func ResourceDnsRecord() *schema.Resource {
 			},
 			"value": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Value for the DNS record (IP, hostname, etc.).",
+				Optional:    true,
+				Computed:    true,
+				Description: "Value for the DNS record (IP, hostname, etc.). Derived from the srv or caa block for SRV and CAA records.",
 			},
 			"ttl": {
 				Type:        schema.TypeInt,
@@ -54,6 +70,76 @@ func ResourceDnsRecord() *schema.Resource {
 				Optional:    true,
 				Description: "Priority for MX or SRV records.",
 			},
+			"srv": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"caa"},
+				Description:   "Structured fields of an SRV record.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9-]+$`), "must be a service name without the leading underscore (e.g., sip)"),
+							Description:  "Symbolic name of the service (e.g., sip).",
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "tls"}, false),
+							Description:  "Transport protocol of the service (tcp, udp or tls).",
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 65535),
+							Description:  "Relative weight for records with the same priority.",
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+							Description:  "Port the service listens on.",
+						},
+						"target": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Host name providing the service.",
+						},
+					},
+				},
+			},
+			"caa": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"srv"},
+				Description:   "Structured fields of a CAA record.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flag": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 255),
+							Description:  "CAA flags (128 marks the property as critical).",
+						},
+						"tag": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"issue", "issuewild", "iodef"}, false),
+							Description:  "CAA property tag (issue, issuewild or iodef).",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "CAA property value (e.g., letsencrypt.org).",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -63,16 +149,7 @@ func resourceDnsRecordCreate(ctx context.Context, d *schema.ResourceData, m inte
 
 	domainID := d.Get("domain_id").(string)
 
-	reqBody := map[string]interface{}{
-		"type":  d.Get("type").(string),
-		"host":  d.Get("host").(string),
-		"value": d.Get("value").(string),
-		"ttl":   d.Get("ttl").(int),
-	}
-
-	if v, ok := d.GetOk("priority"); ok {
-		reqBody["priority"] = v.(int)
-	}
+	reqBody := expandDnsRecordRequest(d)
 
 	path := fmt.Sprintf("/api/v2/domains/%s/dns/records", domainID)
 	respBody, diags := client.Post(ctx, path, reqBody)
@@ -99,50 +176,120 @@ func resourceDnsRecordRead(ctx context.Context, d *schema.ResourceData, m interf
 	recordID := d.Id()
 
 	path := fmt.Sprintf("/api/v2/domains/%s/dns/records/%s", domainID, recordID)
-	respBody, diags := client.Get(ctx, path)
+	respBody, found, diags := client.GetIfExists(ctx, path)
 	if diags.HasError() {
 		return diags
 	}
 
-	var resp struct {
-		ID       string `json:"id"`
-		Type     string `json:"type"`
-		Host     string `json:"host"`
-		Value    string `json:"value"`
-		TTL      int    `json:"ttl"`
-		Priority int    `json:"priority,omitempty"`
+	// Plesk renumbers records when a zone is rebuilt from the DNS template,
+	// so look the record up by its contents before giving up on it.
+	if !found {
+		return resourceDnsRecordReadByContent(ctx, d, m)
 	}
+
+	var resp dnsRecord
 	if err := json.Unmarshal(respBody, &resp); err != nil {
 		return diag.Errorf("failed to parse DNS record read response: %s", err)
 	}
 
-	d.Set("domain_id", domainID)
-	d.Set("type", resp.Type)
-	d.Set("host", resp.Host)
-	d.Set("value", resp.Value)
-	d.Set("ttl", resp.TTL)
-	d.Set("priority", resp.Priority)
+	// The zone-relative host of an SRV record written from the srv block
+	// can only be worked out against the domain name.
+	var domainName string
+	if len(d.Get("srv").([]interface{})) > 0 {
+		if domainName, diags = getDomainName(ctx, client, domainID); diags.HasError() {
+			return diags
+		}
+	}
 
+	setDnsRecordData(d, domainID, domainName, resp)
 	return nil
 }
 
-func resourceDnsRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+// resourceDnsRecordReadByContent finds the record by type, host, value and
+// priority and adopts its new ID, or removes the resource from state when the zone
+// no longer contains a matching record.
+func resourceDnsRecordReadByContent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
 
 	domainID := d.Get("domain_id").(string)
-	recordID := d.Id()
 
-	reqBody := map[string]interface{}{
-		"type":  d.Get("type").(string),
-		"host":  d.Get("host").(string),
-		"value": d.Get("value").(string),
-		"ttl":   d.Get("ttl").(int),
+	domainName, diags := getDomainName(ctx, client, domainID)
+	if diags.HasError() {
+		return diags
 	}
 
-	if v, ok := d.GetOk("priority"); ok {
-		reqBody["priority"] = v.(int)
+	records, diags := listDnsZoneRecords(ctx, client, domainID, domainName, false, nil)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Match on the same key as plesk_dns_zone: the type regardless of case,
+	// the normalized host and value, and the priority, so that MX records
+	// differing only by priority are told apart.
+	want := expandDnsRecordRequest(d)
+	wantKey := dnsRecord{
+		Type:     want["type"].(string),
+		Host:     want["host"].(string),
+		Value:    want["value"].(string),
+		Priority: d.Get("priority").(int),
+	}.key(domainName)
+
+	for _, r := range records {
+		if r.key(domainName) != wantKey {
+			continue
+		}
+
+		log.Printf("[INFO] DNS record %s of domain %s was renumbered to %s", d.Id(), domainID, r.ID)
+		d.SetId(r.ID)
+		setDnsRecordData(d, domainID, domainName, r)
+		return nil
 	}
 
+	log.Printf("[WARN] DNS record %s of domain %s not found, removing from state", d.Id(), domainID)
+	d.SetId("")
+	return nil
+}
+
+// setDnsRecordData stores a record read from Plesk. SRV and CAA records are
+// only split into the srv and caa blocks when the resource already uses
+// them, so records managed through host and value keep those verbatim.
+func setDnsRecordData(d *schema.ResourceData, domainID, domainName string, r dnsRecord) {
+	currentHost := d.Get("host").(string)
+
+	d.Set("domain_id", domainID)
+	d.Set("type", r.Type)
+	d.Set("host", r.Host)
+	d.Set("value", r.Value)
+	d.Set("ttl", r.TTL)
+	d.Set("priority", r.Priority)
+
+	if r.Type == "SRV" && len(d.Get("srv").([]interface{})) > 0 {
+		if srv := flattenDnsRecordSRV(r.Host, r.Value, domainName); srv != nil {
+			host := srv["host"].(string)
+			if host == "" && currentHost == "@" {
+				host = currentHost
+			}
+			d.Set("host", host)
+			delete(srv, "host")
+			d.Set("srv", []interface{}{srv})
+		}
+	}
+
+	if r.Type == "CAA" && len(d.Get("caa").([]interface{})) > 0 {
+		if caa := flattenDnsRecordCAA(r.Value); caa != nil {
+			d.Set("caa", []interface{}{caa})
+		}
+	}
+}
+
+func resourceDnsRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	domainID := d.Get("domain_id").(string)
+	recordID := d.Id()
+
+	reqBody := expandDnsRecordRequest(d)
+
 	path := fmt.Sprintf("/api/v2/domains/%s/dns/records/%s", domainID, recordID)
 	_, diags := client.Put(ctx, path, reqBody)
 	if diags.HasError() {
@@ -161,3 +308,298 @@ func resourceDnsRecordDelete(ctx context.Context, d *schema.ResourceData, m inte
 	path := fmt.Sprintf("/api/v2/domains/%s/dns/records/%s", domainID, recordID)
 	return client.Delete(ctx, path)
 }
+
+// expandDnsRecordRequest builds the create and update request body. SRV and
+// CAA records are sent with the host and value composed from their
+// structured fields.
+func expandDnsRecordRequest(d *schema.ResourceData) map[string]interface{} {
+	reqBody := map[string]interface{}{
+		"type":  d.Get("type").(string),
+		"host":  d.Get("host").(string),
+		"value": d.Get("value").(string),
+		"ttl":   d.Get("ttl").(int),
+	}
+
+	if v, ok := d.GetOk("priority"); ok {
+		reqBody["priority"] = v.(int)
+	}
+
+	if v, ok := d.GetOk("srv"); ok {
+		srv := v.([]interface{})[0].(map[string]interface{})
+		host := fmt.Sprintf("_%s._%s", srv["service"].(string), srv["protocol"].(string))
+		if h := d.Get("host").(string); h != "" && h != "@" {
+			host = host + "." + h
+		}
+		reqBody["host"] = host
+		reqBody["value"] = fmt.Sprintf("%d %d %s", srv["weight"].(int), srv["port"].(int), srv["target"].(string))
+	}
+
+	if v, ok := d.GetOk("caa"); ok {
+		caa := v.([]interface{})[0].(map[string]interface{})
+		reqBody["value"] = fmt.Sprintf("%d %s %q", caa["flag"].(int), caa["tag"].(string), caa["value"].(string))
+	}
+
+	return reqBody
+}
+
+// flattenDnsRecordSRV splits an SRV record into its structured fields. The
+// returned "host" entry is the zone-relative host without the service and
+// protocol labels, or "" for the zone apex.
+func flattenDnsRecordSRV(host, value, domainName string) map[string]interface{} {
+	labels := strings.SplitN(relativeDnsRecordHost(host, domainName), ".", 3)
+	fields := strings.Fields(value)
+	if len(labels) < 2 || len(fields) != 3 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+		return nil
+	}
+
+	weight, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return nil
+	}
+	port, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return nil
+	}
+
+	srv := map[string]interface{}{
+		"service":  strings.TrimPrefix(labels[0], "_"),
+		"protocol": strings.TrimPrefix(labels[1], "_"),
+		"weight":   weight,
+		"port":     port,
+		"target":   fields[2],
+		"host":     "",
+	}
+	if len(labels) == 3 {
+		srv["host"] = labels[2]
+	}
+
+	return srv
+}
+
+// relativeDnsRecordHost strips the domain name from a fully qualified host,
+// returning "" for the zone apex. Hosts outside the domain are returned
+// unchanged apart from the trailing dot.
+func relativeDnsRecordHost(host, domainName string) string {
+	host = strings.TrimSuffix(host, ".")
+	domainName = strings.TrimSuffix(domainName, ".")
+	if domainName == "" {
+		return host
+	}
+
+	switch lower := strings.ToLower(host); {
+	case lower == strings.ToLower(domainName):
+		return ""
+	case strings.HasSuffix(lower, "."+strings.ToLower(domainName)):
+		return host[:len(host)-len(domainName)-1]
+	}
+
+	return host
+}
+
+// flattenDnsRecordCAA splits a CAA record value of the form
+// `<flag> <tag> "<value>"` into its structured fields.
+func flattenDnsRecordCAA(value string) map[string]interface{} {
+	fields := strings.SplitN(value, " ", 3)
+	if len(fields) != 3 {
+		return nil
+	}
+
+	flag, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"flag":  flag,
+		"tag":   fields[1],
+		"value": strings.Trim(fields[2], `"`),
+	}
+}
+
+// resourceDnsRecordCustomizeDiff catches invalid records before they reach
+// the API.
+func resourceDnsRecordCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// value is Computed, so d.Get returns the old or an unknown value when
+	// it is not configured. Check the configuration itself instead.
+	raw := d.GetRawConfig()
+	if raw.IsNull() {
+		return nil
+	}
+
+	rawValue := raw.GetAttr("value")
+	valueConfigured := !rawValue.IsNull()
+	hasSRV := rawDnsRecordBlockSet(raw.GetAttr("srv"))
+	hasCAA := rawDnsRecordBlockSet(raw.GetAttr("caa"))
+
+	// The value of SRV and CAA records is rendered from their block, so a
+	// changed block changes the value.
+	if !valueConfigured && (d.HasChange("srv") || d.HasChange("caa")) {
+		if err := d.SetNewComputed("value"); err != nil {
+			return err
+		}
+	}
+
+	if !d.NewValueKnown("type") || !d.NewValueKnown("host") {
+		return nil
+	}
+	recordType := d.Get("type").(string)
+	host := d.Get("host").(string)
+
+	switch recordType {
+	case "SRV":
+		if !hasSRV && !valueConfigured {
+			return fmt.Errorf("SRV records require an srv block or a value")
+		}
+		return nil
+	case "CAA":
+		if !hasCAA && !valueConfigured {
+			return fmt.Errorf("CAA records require a caa block or a value")
+		}
+		return nil
+	}
+
+	if hasSRV {
+		return fmt.Errorf("the srv block can only be used with SRV records")
+	}
+	if hasCAA {
+		return fmt.Errorf("the caa block can only be used with CAA records")
+	}
+	if !valueConfigured {
+		return fmt.Errorf("value is required for %s records", recordType)
+	}
+	if !rawValue.IsKnown() {
+		return nil
+	}
+	value := rawValue.AsString()
+
+	switch recordType {
+	case "A":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("value %q is not a valid IPv4 address for an A record", value)
+		}
+	case "AAAA":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("value %q is not a valid IPv6 address for an AAAA record", value)
+		}
+	case "TXT":
+		if err := validateTxtRecordValue(value); err != nil {
+			return err
+		}
+	case "CNAME":
+		apex, err := isDnsZoneApex(ctx, d, m, host)
+		if err != nil {
+			return err
+		}
+		if apex {
+			return fmt.Errorf("CNAME records cannot be created at the zone apex")
+		}
+	}
+
+	return nil
+}
+
+// rawDnsRecordBlockSet reports whether a srv or caa block is configured. A
+// block whose presence is not known yet counts as configured.
+func rawDnsRecordBlockSet(block cty.Value) bool {
+	if block.IsNull() {
+		return false
+	}
+	if !block.IsKnown() {
+		return true
+	}
+
+	return block.LengthInt() > 0
+}
+
+// validateTxtRecordValue checks that every quoted character-string of a TXT
+// value fits in 255 bytes. Unquoted values are left alone because Plesk
+// splits them into strings of the allowed length itself.
+func validateTxtRecordValue(value string) error {
+	for _, match := range txtStringPattern.FindAllStringSubmatch(value, -1) {
+		if len(match[1]) > txtStringMaxLength {
+			return fmt.Errorf("TXT record strings must not exceed %d characters (got %d); split the value into multiple quoted strings", txtStringMaxLength, len(match[1]))
+		}
+	}
+
+	return nil
+}
+
+// isDnsZoneApex reports whether host refers to the zone apex. Fully
+// qualified hosts are compared against the domain name, which requires a
+// lookup and is skipped while the domain ID is still unknown.
+func isDnsZoneApex(ctx context.Context, d *schema.ResourceDiff, m interface{}, host string) (bool, error) {
+	host = strings.TrimSuffix(host, ".")
+	if host == "" || host == "@" {
+		return true, nil
+	}
+	if !strings.Contains(host, ".") || !d.NewValueKnown("domain_id") {
+		return false, nil
+	}
+
+	domainName, diags := getDomainName(ctx, m.(*Client), d.Get("domain_id").(string))
+	if diags.HasError() {
+		return false, fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	return strings.EqualFold(host, domainName), nil
+}
+
+// normalizeDnsRecordHost turns a record host into a lowercase fully
+// qualified name without the trailing dot, expanding the <domain>
+// placeholder used by the DNS template.
+func normalizeDnsRecordHost(host, domainName string) string {
+	domainName = strings.ToLower(strings.TrimSuffix(domainName, "."))
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	host = strings.ReplaceAll(host, "<domain>", domainName)
+
+	switch {
+	case host == "" || host == "@":
+		return domainName
+	case host == domainName || strings.HasSuffix(host, "."+domainName):
+		return host
+	default:
+		return host + "." + domainName
+	}
+}
+
+// normalizeDnsRecordValue expands the <domain> placeholder in a record
+// value. For record types whose value is a host name it drops the trailing
+// dot and case differences; TXT values made of quoted strings are joined
+// into the unquoted text.
+func normalizeDnsRecordValue(recordType, value, domainName string) string {
+	value = strings.ReplaceAll(value, "<domain>", strings.TrimSuffix(domainName, "."))
+
+	switch recordType {
+	case "CNAME", "MX", "NS", "PTR", "DNAME", "SRV":
+		return strings.ToLower(strings.TrimSuffix(value, "."))
+	case "TXT":
+		return unquoteTxtRecordValue(value)
+	}
+
+	return value
+}
+
+// unquoteTxtRecordValue joins the character-strings of a TXT value written
+// as one or more quoted strings. Other values are returned unchanged.
+func unquoteTxtRecordValue(value string) string {
+	trimmed := strings.TrimSpace(value)
+	matches := txtStringPattern.FindAllStringSubmatchIndex(trimmed, -1)
+	if matches == nil {
+		return value
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, match := range matches {
+		if strings.TrimSpace(trimmed[last:match[0]]) != "" {
+			return value
+		}
+		b.WriteString(strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(trimmed[match[2]:match[3]]))
+		last = match[1]
+	}
+	if strings.TrimSpace(trimmed[last:]) != "" {
+		return value
+	}
+
+	return b.String()
+}
@@ -0,0 +1,372 @@
+package plesk
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestFlattenDnsRecordSRV(t *testing.T) {
+	cases := []struct {
+		name       string
+		host       string
+		value      string
+		domainName string
+		want       map[string]interface{}
+	}{
+		{
+			name:  "relative host at apex",
+			host:  "_sip._tcp",
+			value: "5 5060 sip.example.com",
+			want: map[string]interface{}{
+				"service": "sip", "protocol": "tcp", "weight": 5, "port": 5060, "target": "sip.example.com", "host": "",
+			},
+		},
+		{
+			name:       "fully qualified host at apex",
+			host:       "_sip._tcp.example.com.",
+			value:      "5 5060 sip.example.com.",
+			domainName: "example.com",
+			want: map[string]interface{}{
+				"service": "sip", "protocol": "tcp", "weight": 5, "port": 5060, "target": "sip.example.com.", "host": "",
+			},
+		},
+		{
+			name:       "fully qualified host below apex",
+			host:       "_xmpp._tcp.chat.eu.example.com.",
+			value:      "0 5222 xmpp.example.com",
+			domainName: "example.com",
+			want: map[string]interface{}{
+				"service": "xmpp", "protocol": "tcp", "weight": 0, "port": 5222, "target": "xmpp.example.com", "host": "chat.eu",
+			},
+		},
+		{
+			name:  "missing protocol label",
+			host:  "_sip.example.com",
+			value: "5 5060 sip.example.com",
+			want:  nil,
+		},
+		{
+			name:  "value with priority",
+			host:  "_sip._tcp",
+			value: "10 5 5060 sip.example.com",
+			want:  nil,
+		},
+		{
+			name:  "non-numeric port",
+			host:  "_sip._tcp",
+			value: "5 sip sip.example.com",
+			want:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := flattenDnsRecordSRV(tc.host, tc.value, tc.domainName)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("flattenDnsRecordSRV(%q, %q, %q) = %v, want %v", tc.host, tc.value, tc.domainName, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFlattenDnsRecordCAA(t *testing.T) {
+	cases := []struct {
+		value string
+		want  map[string]interface{}
+	}{
+		{`0 issue "letsencrypt.org"`, map[string]interface{}{"flag": 0, "tag": "issue", "value": "letsencrypt.org"}},
+		{`128 iodef "mailto:security@example.com"`, map[string]interface{}{"flag": 128, "tag": "iodef", "value": "mailto:security@example.com"}},
+		{`0 issue letsencrypt.org`, map[string]interface{}{"flag": 0, "tag": "issue", "value": "letsencrypt.org"}},
+		{`issue "letsencrypt.org"`, nil},
+		{`x issue "letsencrypt.org"`, nil},
+	}
+
+	for _, tc := range cases {
+		got := flattenDnsRecordCAA(tc.value)
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("flattenDnsRecordCAA(%q) = %v, want %v", tc.value, got, tc.want)
+		}
+	}
+}
+
+func TestValidateTxtRecordValue(t *testing.T) {
+	long := strings.Repeat("a", 318)
+	chunk := strings.Repeat("b", 255)
+
+	cases := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"short unquoted", "v=spf1 -all", false},
+		{"long unquoted DKIM key", "v=DKIM1; k=rsa; p=" + long, false},
+		{"quoted strings within limit", `"` + chunk + `" "` + chunk + `"`, false},
+		{"quoted string over limit", `"` + chunk + `b"`, true},
+		{"second quoted string over limit", `"abc" "` + long + `"`, true},
+		{"escaped quote", `"say \"hi\""`, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateTxtRecordValue(tc.value)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validateTxtRecordValue() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestNormalizeDnsRecordHost(t *testing.T) {
+	cases := []struct {
+		host, domainName, want string
+	}{
+		{"", "example.com", "example.com"},
+		{"@", "example.com", "example.com"},
+		{"www", "example.com", "www.example.com"},
+		{"WWW.Example.com.", "example.com", "www.example.com"},
+		{"example.com.", "Example.COM.", "example.com"},
+		{"mail.<domain>.", "example.com", "mail.example.com"},
+		{"<domain>", "example.com", "example.com"},
+		{"_sip._tcp", "example.com", "_sip._tcp.example.com"},
+	}
+
+	for _, tc := range cases {
+		if got := normalizeDnsRecordHost(tc.host, tc.domainName); got != tc.want {
+			t.Errorf("normalizeDnsRecordHost(%q, %q) = %q, want %q", tc.host, tc.domainName, got, tc.want)
+		}
+	}
+}
+
+func TestNormalizeDnsRecordValue(t *testing.T) {
+	cases := []struct {
+		recordType, value, want string
+	}{
+		{"CNAME", "Target.Example.com.", "target.example.com"},
+		{"MX", "mail.<domain>.", "mail.example.com"},
+		{"TXT", "v=spf1 include:<domain> -all", "v=spf1 include:example.com -all"},
+		{"TXT", "Case Matters.", "Case Matters."},
+		{"TXT", `"v=spf1 -all"`, "v=spf1 -all"},
+		{"TXT", `"v=DKIM1; p=abc" "def"`, "v=DKIM1; p=abcdef"},
+		{"TXT", `"say \"hi\""`, `say "hi"`},
+		{"TXT", `"a" trailing`, `"a" trailing`},
+		{"A", "192.0.2.1", "192.0.2.1"},
+	}
+
+	for _, tc := range cases {
+		if got := normalizeDnsRecordValue(tc.recordType, tc.value, "example.com"); got != tc.want {
+			t.Errorf("normalizeDnsRecordValue(%q, %q) = %q, want %q", tc.recordType, tc.value, got, tc.want)
+		}
+	}
+}
+
+func TestSetDnsRecordData(t *testing.T) {
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		record   dnsRecord
+		wantHost string
+		wantSRV  int
+		wantCAA  int
+	}{
+		{
+			name:     "SRV record managed through host and value",
+			config:   map[string]interface{}{"type": "SRV", "host": "_sip._tcp", "value": "5 5060 sip.example.com"},
+			record:   dnsRecord{Type: "SRV", Host: "_sip._tcp", Value: "5 5060 sip.example.com"},
+			wantHost: "_sip._tcp",
+		},
+		{
+			name: "SRV record managed through the srv block at the apex",
+			config: map[string]interface{}{"type": "SRV", "host": "@", "srv": []interface{}{map[string]interface{}{
+				"service": "sip", "protocol": "tcp", "weight": 5, "port": 5060, "target": "sip.example.com",
+			}}},
+			record:   dnsRecord{Type: "SRV", Host: "_sip._tcp.example.com.", Value: "5 5060 sip.example.com"},
+			wantHost: "@",
+			wantSRV:  1,
+		},
+		{
+			name: "SRV record managed through the srv block below the apex",
+			config: map[string]interface{}{"type": "SRV", "host": "eu", "srv": []interface{}{map[string]interface{}{
+				"service": "sip", "protocol": "tcp", "weight": 5, "port": 5060, "target": "sip.example.com",
+			}}},
+			record:   dnsRecord{Type: "SRV", Host: "_sip._tcp.eu.example.com.", Value: "5 5060 sip.example.com"},
+			wantHost: "eu",
+			wantSRV:  1,
+		},
+		{
+			name:     "CAA record managed through the value",
+			config:   map[string]interface{}{"type": "CAA", "host": "@", "value": `0 issue "letsencrypt.org"`},
+			record:   dnsRecord{Type: "CAA", Host: "@", Value: `0 issue "letsencrypt.org"`},
+			wantHost: "@",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceDnsRecord().Schema, tc.config)
+			setDnsRecordData(d, "1", "example.com", tc.record)
+
+			if got := d.Get("host").(string); got != tc.wantHost {
+				t.Errorf("host = %q, want %q", got, tc.wantHost)
+			}
+			if got := len(d.Get("srv").([]interface{})); got != tc.wantSRV {
+				t.Errorf("srv blocks = %d, want %d", got, tc.wantSRV)
+			}
+			if got := len(d.Get("caa").([]interface{})); got != tc.wantCAA {
+				t.Errorf("caa blocks = %d, want %d", got, tc.wantCAA)
+			}
+			if got := d.Get("value").(string); got != tc.record.Value {
+				t.Errorf("value = %q, want %q", got, tc.record.Value)
+			}
+		})
+	}
+}
+
+func TestResourceDnsRecordCustomizeDiff(t *testing.T) {
+	srvState := map[string]string{
+		"id":             "1",
+		"domain_id":      "1",
+		"type":           "SRV",
+		"host":           "@",
+		"value":          "5 5060 old.example.com",
+		"ttl":            "3600",
+		"srv.#":          "1",
+		"srv.0.service":  "sip",
+		"srv.0.protocol": "tcp",
+		"srv.0.weight":   "5",
+		"srv.0.port":     "5060",
+		"srv.0.target":   "old.example.com",
+	}
+	srvConfig := func(target string) string {
+		return `{"domain_id":"1","type":"SRV","host":"@","srv":[{"service":"sip","protocol":"tcp","weight":5,"port":5060,"target":"` + target + `"}]}`
+	}
+
+	cases := []struct {
+		name              string
+		state             map[string]string
+		config            string
+		wantErr           string
+		wantValueComputed bool
+	}{
+		{
+			name:   "A record",
+			config: `{"domain_id":"1","type":"A","host":"www","value":"192.0.2.1"}`,
+		},
+		{
+			name:    "A record without value",
+			config:  `{"domain_id":"1","type":"A","host":"www"}`,
+			wantErr: "value is required for A records",
+		},
+		{
+			name:    "A record with an IPv6 address",
+			config:  `{"domain_id":"1","type":"A","host":"www","value":"2001:db8::1"}`,
+			wantErr: "not a valid IPv4 address",
+		},
+		{
+			name:    "srv block on an MX record",
+			config:  `{"domain_id":"1","type":"MX","host":"@","value":"mail.example.com","srv":[{"service":"sip","protocol":"tcp","port":5060,"target":"sip.example.com"}]}`,
+			wantErr: "the srv block can only be used with SRV records",
+		},
+		{
+			name:    "SRV record without block or value",
+			config:  `{"domain_id":"1","type":"SRV","host":"_sip._tcp"}`,
+			wantErr: "SRV records require an srv block or a value",
+		},
+		{
+			name:              "new SRV record from a block",
+			config:            srvConfig("sip.example.com"),
+			wantValueComputed: true,
+		},
+		{
+			name:              "changed srv block",
+			state:             srvState,
+			config:            srvConfig("new.example.com"),
+			wantValueComputed: true,
+		},
+		{
+			name:   "unchanged srv block",
+			state:  srvState,
+			config: srvConfig("old.example.com"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := ResourceDnsRecord()
+
+			config, err := ctyjson.Unmarshal([]byte(tc.config), r.CoreConfigSchema().ImpliedType())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The raw configuration reaches CustomizeDiff through the prior
+			// state, as it does when Terraform plans a change.
+			state := &terraform.InstanceState{ID: tc.state["id"], Attributes: tc.state, RawConfig: config}
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigShimmed(config, r.CoreConfigSchema()), nil)
+
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("Diff() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Diff() error = %v", err)
+			}
+
+			valueComputed := diff != nil && diff.Attributes["value"] != nil && diff.Attributes["value"].NewComputed
+			if valueComputed != tc.wantValueComputed {
+				t.Errorf("value computed = %v, want %v", valueComputed, tc.wantValueComputed)
+			}
+		})
+	}
+}
+
+func TestResourceDnsRecordReadByContent(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/domains/1":
+			w.Write([]byte(`{"id":"1","name":"example.com"}`))
+		case "/api/v2/domains/1/dns/records":
+			w.Write([]byte(`{"records":[
+				{"id":"10","type":"mx","host":"example.com.","value":"mail.example.com.","ttl":3600,"priority":10},
+				{"id":"20","type":"mx","host":"example.com.","value":"mail.example.com.","ttl":3600,"priority":20},
+				{"id":"30","type":"A","host":"www.example.com.","value":"192.0.2.1","ttl":3600}
+			]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	cases := []struct {
+		name   string
+		config map[string]interface{}
+		wantID string
+	}{
+		{"MX record by priority", map[string]interface{}{"type": "MX", "host": "@", "value": "mail.example.com", "priority": 20}, "20"},
+		{"MX record with the other priority", map[string]interface{}{"type": "MX", "host": "@", "value": "mail.example.com", "priority": 10}, "10"},
+		{"MX record with an unknown priority", map[string]interface{}{"type": "MX", "host": "@", "value": "mail.example.com", "priority": 5}, ""},
+		{"A record", map[string]interface{}{"type": "A", "host": "www", "value": "192.0.2.1"}, "30"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.config["domain_id"] = "1"
+			d := schema.TestResourceDataRaw(t, ResourceDnsRecord().Schema, tc.config)
+			d.SetId("99")
+
+			if diags := resourceDnsRecordRead(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("resourceDnsRecordRead() diags = %v", diags)
+			}
+			if d.Id() != tc.wantID {
+				t.Errorf("ID = %q, want %q", d.Id(), tc.wantID)
+			}
+		})
+	}
+}
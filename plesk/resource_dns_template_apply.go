@@ -0,0 +1,76 @@
+package plesk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceDnsTemplateApply propagates the server DNS template to existing
+// zones. It performs the apply on creation, so changing triggers or
+// domain_ids re-applies the template; destroying it does nothing.
+func ResourceDnsTemplateApply() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDnsTemplateApplyCreate,
+		ReadContext:   resourceDnsTemplateApplyRead,
+		DeleteContext: resourceDnsTemplateApplyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"domain_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the domains whose zones the template is applied to. Omit to apply it to all zones.",
+			},
+			"reset_zones": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether to replace the zones with the template instead of merging the template records into them.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that re-apply the template when changed, e.g. the IDs and values of plesk_dns_template_record resources.",
+			},
+		},
+	}
+}
+
+func resourceDnsTemplateApplyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	domainIDs := expandStringSet(d.Get("domain_ids").(*schema.Set))
+
+	reqBody := map[string]interface{}{
+		"all":   len(domainIDs) == 0,
+		"reset": d.Get("reset_zones").(bool),
+	}
+	if len(domainIDs) > 0 {
+		reqBody["domains"] = domainIDs
+	}
+
+	if _, diags := client.Post(ctx, "/api/v2/dns/template/apply", reqBody); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("%d", time.Now().UnixNano()))
+	return nil
+}
+
+func resourceDnsTemplateApplyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Applying the template is a one-off action with nothing to read back.
+	return nil
+}
+
+func resourceDnsTemplateApplyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
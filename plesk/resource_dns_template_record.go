@@ -0,0 +1,127 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceDnsTemplateRecord defines a record of the server-wide DNS template
+// that Plesk uses to create the zones of new domains.
+func ResourceDnsTemplateRecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDnsTemplateRecordCreate,
+		ReadContext:   resourceDnsTemplateRecordRead,
+		UpdateContext: resourceDnsTemplateRecordUpdate,
+		DeleteContext: resourceDnsTemplateRecordDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "DNS record type (e.g., A, AAAA, CNAME, MX, TXT, NS, SRV, PTR, CAA, TLSA, DS, DNAME).",
+				ValidateFunc: validation.StringInSlice(dnsRecordTypes, false),
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Host of the record. Use the <domain> placeholder for the domain name (e.g., mail.<domain>.).",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Value of the record. Placeholders such as <domain> and <ip> are expanded per domain.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     3600,
+				Description: "Time To Live (TTL) for the DNS record in seconds.",
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Priority for MX or SRV records.",
+			},
+		},
+	}
+}
+
+func resourceDnsTemplateRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, diags := client.Post(ctx, "/api/v2/dns/template/records", expandDnsTemplateRecord(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse DNS template record create response: %s", err)
+	}
+
+	d.SetId(resp.ID)
+	return resourceDnsTemplateRecordRead(ctx, d, m)
+}
+
+func resourceDnsTemplateRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, found, diags := client.GetIfExists(ctx, fmt.Sprintf("/api/v2/dns/template/records/%s", d.Id()))
+	if diags.HasError() {
+		return diags
+	}
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	var resp dnsRecord
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse DNS template record read response: %s", err)
+	}
+
+	d.Set("type", resp.Type)
+	d.Set("host", resp.Host)
+	d.Set("value", resp.Value)
+	d.Set("ttl", resp.TTL)
+	d.Set("priority", resp.Priority)
+
+	return nil
+}
+
+func resourceDnsTemplateRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	path := fmt.Sprintf("/api/v2/dns/template/records/%s", d.Id())
+	if _, diags := client.Put(ctx, path, expandDnsTemplateRecord(d)); diags.HasError() {
+		return diags
+	}
+
+	return resourceDnsTemplateRecordRead(ctx, d, m)
+}
+
+func resourceDnsTemplateRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	return client.Delete(ctx, fmt.Sprintf("/api/v2/dns/template/records/%s", d.Id()))
+}
+
+func expandDnsTemplateRecord(d *schema.ResourceData) dnsRecord {
+	return dnsRecord{
+		Type:     d.Get("type").(string),
+		Host:     d.Get("host").(string),
+		Value:    d.Get("value").(string),
+		TTL:      d.Get("ttl").(int),
+		Priority: d.Get("priority").(int),
+	}
+}
@@ -0,0 +1,338 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceDnsZone manages the complete set of DNS records of a domain.
+// Records that exist in Plesk but not in the configuration are deleted,
+// except for Plesk-managed records when ignore_plesk_managed is set.
+func ResourceDnsZone() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDnsZoneCreate,
+		ReadContext:   resourceDnsZoneRead,
+		UpdateContext: resourceDnsZoneUpdate,
+		DeleteContext: resourceDnsZoneDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the domain whose zone is managed.",
+			},
+			"ignore_plesk_managed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to leave records managed by Plesk, such as the default NS and webmail entries, untouched.",
+			},
+			"record": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "DNS records of the zone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(dnsRecordTypes, false),
+							Description:  "DNS record type.",
+						},
+						"host": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Host or subdomain for the DNS record.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value for the DNS record.",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     3600,
+							Description: "Time To Live (TTL) for the DNS record in seconds.",
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "Priority for MX or SRV records.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dnsRecord mirrors a DNS record as returned by /api/v2/domains/{id}/dns/records.
+type dnsRecord struct {
+	ID       string `json:"id,omitempty"`
+	Type     string `json:"type"`
+	Host     string `json:"host"`
+	Value    string `json:"value"`
+	TTL      int    `json:"ttl"`
+	Priority int    `json:"priority,omitempty"`
+}
+
+// key identifies a record independently of its ID and TTL. Hosts and values
+// are normalized against the domain name, so that "www" matches the
+// "www.example.com." returned by Plesk.
+func (r dnsRecord) key(domainName string) string {
+	recordType := strings.ToUpper(r.Type)
+	return fmt.Sprintf("%s|%s|%s|%d", recordType,
+		normalizeDnsRecordHost(r.Host, domainName),
+		normalizeDnsRecordValue(recordType, r.Value, domainName),
+		r.Priority)
+}
+
+func resourceDnsZoneCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("domain_id").(string))
+
+	if diags := resourceDnsZoneReconcile(ctx, d, m); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceDnsZoneRead(ctx, d, m)
+}
+
+func resourceDnsZoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	domainName, diags := getDomainName(ctx, client, d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	// Keep the configured spelling of records Plesk returns in another form,
+	// e.g. with a fully qualified host or a quoted TXT value.
+	configured := map[string]dnsRecord{}
+	for _, r := range expandDnsZoneRecords(d) {
+		configured[r.key(domainName)] = r
+	}
+
+	records, diags := listDnsZoneRecords(ctx, client, d.Id(), domainName, d.Get("ignore_plesk_managed").(bool), configured)
+	if diags.HasError() {
+		return diags
+	}
+
+	flattened := make([]interface{}, 0, len(records))
+	for _, r := range records {
+		if c, ok := configured[r.key(domainName)]; ok {
+			r.Host, r.Value = c.Host, c.Value
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"type":     r.Type,
+			"host":     r.Host,
+			"value":    r.Value,
+			"ttl":      r.TTL,
+			"priority": r.Priority,
+		})
+	}
+
+	d.Set("domain_id", d.Id())
+	d.Set("record", flattened)
+
+	return nil
+}
+
+func resourceDnsZoneUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := resourceDnsZoneReconcile(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return resourceDnsZoneRead(ctx, d, m)
+}
+
+func resourceDnsZoneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	domainName, diags := getDomainName(ctx, client, d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	// Only remove the records that are part of the configuration, including
+	// configured records Plesk also manages; the zone itself belongs to the
+	// domain.
+	managed := map[string]bool{}
+	for _, r := range expandDnsZoneRecords(d) {
+		managed[r.key(domainName)] = true
+	}
+
+	records, diags := listDnsZoneRecords(ctx, client, d.Id(), domainName, false, nil)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, r := range records {
+		if !managed[r.key(domainName)] {
+			continue
+		}
+		path := fmt.Sprintf("/api/v2/domains/%s/dns/records/%s", d.Id(), r.ID)
+		if diags := client.Delete(ctx, path); diags.HasError() {
+			return diags
+		}
+	}
+
+	return nil
+}
+
+// resourceDnsZoneReconcile creates, updates and deletes records until the
+// zone holds exactly the configured set. Missing records are created before
+// anything is deleted, so a failed request never leaves the zone with fewer
+// records than it started with.
+func resourceDnsZoneReconcile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	domainID := d.Id()
+
+	domainName, diags := getDomainName(ctx, client, domainID)
+	if diags.HasError() {
+		return diags
+	}
+
+	desired := map[string]dnsRecord{}
+	for _, r := range expandDnsZoneRecords(d) {
+		desired[r.key(domainName)] = r
+	}
+
+	existing, diags := listDnsZoneRecords(ctx, client, domainID, domainName, d.Get("ignore_plesk_managed").(bool), desired)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Keep the first existing record for every desired key; the rest,
+	// including duplicates of a desired record, are deleted at the end.
+	current := make(map[string]dnsRecord, len(existing))
+	var obsolete []dnsRecord
+	for _, r := range existing {
+		key := r.key(domainName)
+		if _, wanted := desired[key]; wanted {
+			if _, seen := current[key]; !seen {
+				current[key] = r
+				continue
+			}
+		}
+		obsolete = append(obsolete, r)
+	}
+
+	for key, r := range desired {
+		existingRecord, ok := current[key]
+		switch {
+		case !ok:
+			path := fmt.Sprintf("/api/v2/domains/%s/dns/records", domainID)
+			if _, diags := client.Post(ctx, path, r); diags.HasError() {
+				return diags
+			}
+		case existingRecord.TTL != r.TTL:
+			path := fmt.Sprintf("/api/v2/domains/%s/dns/records/%s", domainID, existingRecord.ID)
+			if _, diags := client.Put(ctx, path, r); diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	for _, r := range obsolete {
+		path := fmt.Sprintf("/api/v2/domains/%s/dns/records/%s", domainID, r.ID)
+		if diags := client.Delete(ctx, path); diags.HasError() {
+			return diags
+		}
+	}
+
+	return nil
+}
+
+func expandDnsZoneRecords(d *schema.ResourceData) []dnsRecord {
+	set := d.Get("record").(*schema.Set)
+
+	records := make([]dnsRecord, 0, set.Len())
+	for _, v := range set.List() {
+		r := v.(map[string]interface{})
+		records = append(records, dnsRecord{
+			Type:     r["type"].(string),
+			Host:     r["host"].(string),
+			Value:    r["value"].(string),
+			TTL:      r["ttl"].(int),
+			Priority: r["priority"].(int),
+		})
+	}
+
+	return records
+}
+
+// listDnsZoneRecords returns the records of a domain's zone, optionally
+// leaving out the records Plesk manages itself. Plesk-managed records whose
+// key is in configured are always returned, so that they can be managed
+// like any other record.
+func listDnsZoneRecords(ctx context.Context, client *Client, domainID, domainName string, ignorePleskManaged bool, configured map[string]dnsRecord) ([]dnsRecord, diag.Diagnostics) {
+	respBody, diags := client.Get(ctx, fmt.Sprintf("/api/v2/domains/%s/dns/records", domainID))
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var resp struct {
+		Records []dnsRecord `json:"records"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, diag.Errorf("failed to parse DNS records response: %s", err)
+	}
+
+	if !ignorePleskManaged {
+		return resp.Records, nil
+	}
+
+	records := make([]dnsRecord, 0, len(resp.Records))
+	for _, r := range resp.Records {
+		if _, ok := configured[r.key(domainName)]; ok || !isPleskManagedDnsRecord(r, domainName) {
+			records = append(records, r)
+		}
+	}
+
+	return records, nil
+}
+
+// isPleskManagedDnsRecord reports whether a record is one Plesk creates and
+// maintains for every domain: the NS records at the zone apex and the
+// webmail entries.
+func isPleskManagedDnsRecord(r dnsRecord, domainName string) bool {
+	host := strings.ToLower(strings.TrimSuffix(r.Host, "."))
+	domainName = strings.ToLower(domainName)
+
+	if r.Type == "NS" && (host == "" || host == "@" || host == domainName) {
+		return true
+	}
+
+	return host == "webmail" || host == "webmail."+domainName
+}
+
+// getDomainName looks up the name of a domain by its ID.
+func getDomainName(ctx context.Context, client *Client, domainID string) (string, diag.Diagnostics) {
+	respBody, diags := client.Get(ctx, fmt.Sprintf("/api/v2/domains/%s", domainID))
+	if diags.HasError() {
+		return "", diags
+	}
+
+	var domain struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(respBody, &domain); err != nil {
+		return "", diag.Errorf("failed to parse domain response: %s", err)
+	}
+
+	return domain.Name, nil
+}
@@ -0,0 +1,208 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceDnsZoneSettings defines the SOA values and the service mode of a domain's DNS zone.
+func ResourceDnsZoneSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDnsZoneSettingsCreate,
+		ReadContext:   resourceDnsZoneSettingsRead,
+		UpdateContext: resourceDnsZoneSettingsUpdate,
+		DeleteContext: resourceDnsZoneSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the domain whose zone is configured.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the DNS service is enabled for the zone.",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "master",
+				ValidateFunc: validation.StringInSlice([]string{"master", "slave"}, false),
+				Description:  "Whether Plesk is the master or a secondary (slave) server for the zone.",
+			},
+			"master_servers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsIPAddress},
+				Description: "IP addresses of the master name servers. Required when mode is slave.",
+			},
+			"primary_ns": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Primary name server reported in the SOA record.",
+			},
+			"admin_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Email address of the zone administrator reported in the SOA record.",
+			},
+			"refresh": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10800,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "SOA refresh interval in seconds.",
+			},
+			"retry": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "SOA retry interval in seconds.",
+			},
+			"expire": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      604800,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "SOA expire time in seconds.",
+			},
+			"minimum_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10800,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "SOA minimum (negative caching) TTL in seconds.",
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      86400,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Default TTL of the zone's records in seconds.",
+			},
+			"serial_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "unixtime",
+				ValidateFunc: validation.StringInSlice([]string{"unixtime", "YYYYMMDDNN"}, false),
+				Description:  "Format of the SOA serial number (unixtime or YYYYMMDDNN).",
+			},
+			"serial": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current SOA serial number.",
+			},
+		},
+	}
+}
+
+// dnsZoneSettingsBody mirrors the body of the /api/v2/domains/{id}/dns/settings endpoint.
+type dnsZoneSettingsBody struct {
+	Enabled       bool     `json:"enabled"`
+	Mode          string   `json:"mode"`
+	MasterServers []string `json:"master_servers"`
+	PrimaryNS     string   `json:"primary_ns,omitempty"`
+	AdminEmail    string   `json:"admin_email,omitempty"`
+	Refresh       int      `json:"refresh"`
+	Retry         int      `json:"retry"`
+	Expire        int      `json:"expire"`
+	MinimumTTL    int      `json:"minimum_ttl"`
+	TTL           int      `json:"ttl"`
+	SerialFormat  string   `json:"serial_format"`
+	Serial        string   `json:"serial,omitempty"`
+}
+
+func resourceDnsZoneSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("domain_id").(string))
+
+	if diags := resourceDnsZoneSettingsPut(ctx, d, m); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceDnsZoneSettingsRead(ctx, d, m)
+}
+
+func resourceDnsZoneSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, diags := client.Get(ctx, fmt.Sprintf("/api/v2/domains/%s/dns/settings", d.Id()))
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp dnsZoneSettingsBody
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse DNS zone settings response: %s", err)
+	}
+
+	d.Set("domain_id", d.Id())
+	d.Set("enabled", resp.Enabled)
+	d.Set("mode", resp.Mode)
+	d.Set("master_servers", resp.MasterServers)
+	d.Set("primary_ns", resp.PrimaryNS)
+	d.Set("admin_email", resp.AdminEmail)
+	d.Set("refresh", resp.Refresh)
+	d.Set("retry", resp.Retry)
+	d.Set("expire", resp.Expire)
+	d.Set("minimum_ttl", resp.MinimumTTL)
+	d.Set("ttl", resp.TTL)
+	d.Set("serial_format", resp.SerialFormat)
+	d.Set("serial", resp.Serial)
+
+	return nil
+}
+
+func resourceDnsZoneSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := resourceDnsZoneSettingsPut(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return resourceDnsZoneSettingsRead(ctx, d, m)
+}
+
+func resourceDnsZoneSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Zone settings cannot be removed from a domain, only changed. Dropping
+	// the resource leaves the current configuration in place.
+	d.SetId("")
+	return nil
+}
+
+func resourceDnsZoneSettingsPut(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	reqBody := dnsZoneSettingsBody{
+		Enabled:       d.Get("enabled").(bool),
+		Mode:          d.Get("mode").(string),
+		MasterServers: expandStringSet(d.Get("master_servers").(*schema.Set)),
+		PrimaryNS:     d.Get("primary_ns").(string),
+		AdminEmail:    d.Get("admin_email").(string),
+		Refresh:       d.Get("refresh").(int),
+		Retry:         d.Get("retry").(int),
+		Expire:        d.Get("expire").(int),
+		MinimumTTL:    d.Get("minimum_ttl").(int),
+		TTL:           d.Get("ttl").(int),
+		SerialFormat:  d.Get("serial_format").(string),
+	}
+
+	if reqBody.Mode == "slave" && len(reqBody.MasterServers) == 0 {
+		return diag.Errorf("master_servers is required when mode is slave")
+	}
+
+	_, diags := client.Put(ctx, fmt.Sprintf("/api/v2/domains/%s/dns/settings", d.Id()), reqBody)
+	return diags
+}
@@ -0,0 +1,115 @@
+package plesk
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDnsRecordKey(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b dnsRecord
+		same bool
+	}{
+		{
+			name: "relative and fully qualified host",
+			a:    dnsRecord{Type: "A", Host: "www", Value: "192.0.2.1"},
+			b:    dnsRecord{Type: "A", Host: "www.example.com.", Value: "192.0.2.1"},
+			same: true,
+		},
+		{
+			name: "apex spellings",
+			a:    dnsRecord{Type: "MX", Host: "@", Value: "mail.example.com", Priority: 10},
+			b:    dnsRecord{Type: "mx", Host: "Example.com.", Value: "Mail.Example.com.", Priority: 10},
+			same: true,
+		},
+		{
+			name: "quoted TXT value",
+			a:    dnsRecord{Type: "TXT", Host: "", Value: "v=spf1 -all"},
+			b:    dnsRecord{Type: "TXT", Host: "example.com.", Value: `"v=spf1 -all"`},
+			same: true,
+		},
+		{
+			name: "TTL is ignored",
+			a:    dnsRecord{Type: "A", Host: "www", Value: "192.0.2.1", TTL: 300},
+			b:    dnsRecord{Type: "A", Host: "www", Value: "192.0.2.1", TTL: 3600},
+			same: true,
+		},
+		{
+			name: "different priority",
+			a:    dnsRecord{Type: "MX", Host: "@", Value: "mail.example.com", Priority: 10},
+			b:    dnsRecord{Type: "MX", Host: "@", Value: "mail.example.com", Priority: 20},
+			same: false,
+		},
+		{
+			name: "TXT case is significant",
+			a:    dnsRecord{Type: "TXT", Host: "@", Value: "Token"},
+			b:    dnsRecord{Type: "TXT", Host: "@", Value: "token"},
+			same: false,
+		},
+		{
+			name: "host in another domain",
+			a:    dnsRecord{Type: "A", Host: "www", Value: "192.0.2.1"},
+			b:    dnsRecord{Type: "A", Host: "www.example.net.", Value: "192.0.2.1"},
+			same: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ka, kb := tc.a.key("example.com"), tc.b.key("example.com")
+			if (ka == kb) != tc.same {
+				t.Errorf("key(%+v) = %q, key(%+v) = %q, want same = %v", tc.a, ka, tc.b, kb, tc.same)
+			}
+		})
+	}
+}
+
+func TestListDnsZoneRecordsIgnorePleskManaged(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"records":[
+			{"id":"1","type":"NS","host":"example.com.","value":"ns1.example.com."},
+			{"id":"2","type":"A","host":"webmail.example.com.","value":"192.0.2.1"},
+			{"id":"3","type":"CNAME","host":"webmail.example.com.","value":"mail.example.com."},
+			{"id":"4","type":"A","host":"www.example.com.","value":"192.0.2.1"}
+		]}`))
+	})
+
+	configured := map[string]dnsRecord{}
+	for _, r := range []dnsRecord{
+		{Type: "A", Host: "webmail", Value: "192.0.2.1"},
+		{Type: "A", Host: "www", Value: "192.0.2.1"},
+	} {
+		configured[r.key("example.com")] = r
+	}
+
+	cases := []struct {
+		name               string
+		ignorePleskManaged bool
+		configured         map[string]dnsRecord
+		wantIDs            []string
+	}{
+		{"no filter", false, nil, []string{"1", "2", "3", "4"}},
+		{"filter", true, nil, []string{"4"}},
+		{"filter keeps configured records", true, configured, []string{"2", "4"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			records, diags := listDnsZoneRecords(context.Background(), client, "1", "example.com", tc.ignorePleskManaged, tc.configured)
+			if diags.HasError() {
+				t.Fatalf("listDnsZoneRecords() diags = %v", diags)
+			}
+
+			var ids []string
+			for _, r := range records {
+				ids = append(ids, r.ID)
+			}
+			if !reflect.DeepEqual(ids, tc.wantIDs) {
+				t.Errorf("record IDs = %v, want %v", ids, tc.wantIDs)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
     "context"
     "encoding/json"
     "fmt"
+    "time"
 
     "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
     "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +15,11 @@ func ResourceExtension() *schema.Resource {
         CreateContext: resourceExtensionInstall,
         ReadContext:   resourceExtensionRead,
         DeleteContext: resourceExtensionUninstall,
+
+        Timeouts: &schema.ResourceTimeout{
+            Create: schema.DefaultTimeout(20 * time.Minute),
+        },
+
         Schema: map[string]*schema.Schema{
             "id": {
                 Type:        schema.TypeString,
@@ -39,11 +45,22 @@ func resourceExtensionInstall(ctx context.Context, d *schema.ResourceData, m int
         "id": extensionID,
     }
 
-    _, diags := client.Post(ctx, "/api/v2/extensions", payload)
+    respBody, diags := client.Post(ctx, "/api/v2/extensions", payload)
     if diags.HasError() {
         return diags
     }
 
+    // Plesk installs extensions in the background and returns the task
+    // tracking the installation.
+    var resp struct {
+        TaskID string `json:"task_id"`
+    }
+    if err := json.Unmarshal(respBody, &resp); err == nil && resp.TaskID != "" {
+        if _, diags = waitForTask(ctx, client, resp.TaskID, fmt.Sprintf("installation of extension %s", extensionID), d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+            return diags
+        }
+    }
+
     d.SetId(extensionID)
 
     // Enable if requested
@@ -0,0 +1,141 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceFail2banJail configures a Fail2Ban jail, such as plesk-apache or
+// recidive. The resource ID is the jail name.
+func ResourceFail2banJail() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFail2banJailCreate,
+		ReadContext:   resourceFail2banJailRead,
+		UpdateContext: resourceFail2banJailUpdate,
+		DeleteContext: resourceFail2banJailDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the jail (e.g., plesk-apache).",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the jail is active.",
+			},
+			"filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the filter that detects failures in the logs. Defaults to the jail's current filter.",
+			},
+			"actions": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Actions run when an IP address is banned, in order (e.g., iptables-multiport[name=apache, port=\"http,https\"]). Defaults to the jail's current actions.",
+			},
+			"log_paths": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Log files the jail monitors. Defaults to the jail's current log files.",
+			},
+		},
+	}
+}
+
+// fail2banJailBody mirrors the body of the /api/v2/fail2ban/jails endpoints.
+type fail2banJailBody struct {
+	Name     string   `json:"name"`
+	Enabled  bool     `json:"enabled"`
+	Filter   string   `json:"filter,omitempty"`
+	Actions  []string `json:"actions,omitempty"`
+	LogPaths []string `json:"log_paths,omitempty"`
+}
+
+func resourceFail2banJailCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("name").(string))
+
+	if diags := resourceFail2banJailPut(ctx, d, m, d.Get("enabled").(bool)); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceFail2banJailRead(ctx, d, m)
+}
+
+func resourceFail2banJailRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, found, diags := client.GetIfExists(ctx, fail2banJailPath(d.Id()))
+	if diags.HasError() {
+		return diags
+	}
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	var jail fail2banJailBody
+	if err := json.Unmarshal(respBody, &jail); err != nil {
+		return diag.Errorf("failed to parse Fail2Ban jail response: %s", err)
+	}
+
+	d.Set("name", d.Id())
+	d.Set("enabled", jail.Enabled)
+	d.Set("filter", jail.Filter)
+	d.Set("actions", jail.Actions)
+	d.Set("log_paths", jail.LogPaths)
+
+	return nil
+}
+
+func resourceFail2banJailUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := resourceFail2banJailPut(ctx, d, m, d.Get("enabled").(bool)); diags.HasError() {
+		return diags
+	}
+
+	return resourceFail2banJailRead(ctx, d, m)
+}
+
+func resourceFail2banJailDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The jails shipped with Plesk cannot be removed, so destroying the
+	// resource switches the jail off and leaves its configuration in place.
+	return resourceFail2banJailPut(ctx, d, m, false)
+}
+
+func resourceFail2banJailPut(ctx context.Context, d *schema.ResourceData, m interface{}, enabled bool) diag.Diagnostics {
+	client := m.(*Client)
+
+	reqBody := fail2banJailBody{
+		Name:     d.Id(),
+		Enabled:  enabled,
+		Filter:   d.Get("filter").(string),
+		LogPaths: expandStringSet(d.Get("log_paths").(*schema.Set)),
+	}
+	for _, action := range d.Get("actions").([]interface{}) {
+		reqBody.Actions = append(reqBody.Actions, action.(string))
+	}
+
+	_, diags := client.Put(ctx, fail2banJailPath(d.Id()), reqBody)
+	return diags
+}
+
+func fail2banJailPath(name string) string {
+	return fmt.Sprintf("/api/v2/fail2ban/jails/%s", url.PathEscape(name))
+}
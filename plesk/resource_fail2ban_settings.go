@@ -0,0 +1,123 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceFail2banSettings defines the server-wide Fail2Ban (IP address
+// banning) settings. The resource ID is always "fail2ban".
+func ResourceFail2banSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFail2banSettingsCreate,
+		ReadContext:   resourceFail2banSettingsRead,
+		UpdateContext: resourceFail2banSettingsUpdate,
+		DeleteContext: resourceFail2banSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether IP address banning is active.",
+			},
+			"ban_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      600,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Seconds an offending IP address stays banned.",
+			},
+			"find_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      600,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Seconds within which max_retries failures lead to a ban.",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of failures within find_time after which an IP address is banned.",
+			},
+		},
+	}
+}
+
+// fail2banSettingsBody mirrors the body of the /api/v2/fail2ban endpoints.
+type fail2banSettingsBody struct {
+	Enabled    bool `json:"enabled"`
+	BanPeriod  int  `json:"ban_period"`
+	FindTime   int  `json:"find_time"`
+	MaxRetries int  `json:"max_retries"`
+}
+
+func resourceFail2banSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("fail2ban")
+
+	if diags := resourceFail2banSettingsPut(ctx, d, m); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceFail2banSettingsRead(ctx, d, m)
+}
+
+func resourceFail2banSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, diags := client.Get(ctx, "/api/v2/fail2ban")
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp fail2banSettingsBody
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse Fail2Ban settings response: %s", err)
+	}
+
+	d.Set("enabled", resp.Enabled)
+	d.Set("ban_period", resp.BanPeriod)
+	d.Set("find_time", resp.FindTime)
+	d.Set("max_retries", resp.MaxRetries)
+
+	return nil
+}
+
+func resourceFail2banSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := resourceFail2banSettingsPut(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return resourceFail2banSettingsRead(ctx, d, m)
+}
+
+func resourceFail2banSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Fail2Ban settings cannot be removed, only changed. Dropping the
+	// resource leaves the current configuration in place.
+	d.SetId("")
+	return nil
+}
+
+func resourceFail2banSettingsPut(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	reqBody := fail2banSettingsBody{
+		Enabled:    d.Get("enabled").(bool),
+		BanPeriod:  d.Get("ban_period").(int),
+		FindTime:   d.Get("find_time").(int),
+		MaxRetries: d.Get("max_retries").(int),
+	}
+
+	_, diags := client.Put(ctx, "/api/v2/fail2ban", reqBody)
+	return diags
+}
@@ -0,0 +1,82 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceFail2banTrustedIP adds an IP address or range to the Fail2Ban
+// trusted list, so it is never banned. The resource ID is the address.
+func ResourceFail2banTrustedIP() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFail2banTrustedIPCreate,
+		ReadContext:   resourceFail2banTrustedIPRead,
+		DeleteContext: resourceFail2banTrustedIPDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.Any(validation.IsIPAddress, validation.IsCIDR),
+				Description:  "IP address or CIDR range to trust.",
+			},
+		},
+	}
+}
+
+func resourceFail2banTrustedIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	address := d.Get("address").(string)
+
+	reqBody := map[string]interface{}{
+		"address": address,
+	}
+	if _, diags := client.Post(ctx, "/api/v2/fail2ban/trusted-ips", reqBody); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(address)
+	return resourceFail2banTrustedIPRead(ctx, d, m)
+}
+
+func resourceFail2banTrustedIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, diags := client.Get(ctx, "/api/v2/fail2ban/trusted-ips")
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp struct {
+		Addresses []string `json:"addresses"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse Fail2Ban trusted IPs response: %s", err)
+	}
+
+	for _, address := range resp.Addresses {
+		if address == d.Id() {
+			d.Set("address", address)
+			return nil
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceFail2banTrustedIPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	return client.Delete(ctx, fmt.Sprintf("/api/v2/fail2ban/trusted-ips/%s", url.PathEscape(d.Id())))
+}
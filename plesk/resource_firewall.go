@@ -0,0 +1,199 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceFirewall enables the Plesk Firewall extension and applies its
+// staged rule changes. Plesk rolls applied rules back unless they are
+// confirmed within a short window; the provider confirms them as soon as
+// Plesk asks, so rules that cut off access to the API are rolled back. The
+// resource ID is always "firewall".
+func ResourceFirewall() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFirewallCreate,
+		ReadContext:   resourceFirewallRead,
+		UpdateContext: resourceFirewallUpdate,
+		DeleteContext: resourceFirewallDelete,
+		CustomizeDiff: resourceFirewallCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the firewall is active.",
+			},
+			"confirmation_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(15, 600),
+				Description:  "Seconds Plesk waits for applied changes to be confirmed before rolling them back.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that apply the firewall configuration again when changed, e.g. the IDs and priorities of plesk_firewall_rule resources created with apply = false.",
+			},
+			"pending_changes": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether there are staged changes that have not been applied yet.",
+			},
+		},
+	}
+}
+
+func resourceFirewallCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("firewall")
+
+	if diags := resourceFirewallApply(ctx, d, m, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceFirewallRead(ctx, d, m)
+}
+
+func resourceFirewallRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, diags := client.Get(ctx, "/api/v2/extensions/firewall")
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp struct {
+		Enabled             bool `json:"enabled"`
+		ConfirmationTimeout int  `json:"confirmation_timeout"`
+		PendingChanges      bool `json:"pending_changes"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse firewall response: %s", err)
+	}
+
+	d.Set("enabled", resp.Enabled)
+	d.Set("confirmation_timeout", resp.ConfirmationTimeout)
+	d.Set("pending_changes", resp.PendingChanges)
+
+	return nil
+}
+
+func resourceFirewallUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := resourceFirewallApply(ctx, d, m, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+		return diags
+	}
+
+	return resourceFirewallRead(ctx, d, m)
+}
+
+func resourceFirewallDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Turning the firewall off on destroy could expose the server, so
+	// dropping the resource leaves the current configuration in place.
+	d.SetId("")
+	return nil
+}
+
+// resourceFirewallCustomizeDiff plans an update when Plesk reports staged
+// changes, e.g. rules changed in the panel or by plesk_firewall_rule, so
+// that they are applied.
+func resourceFirewallCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if pending, _ := d.GetChange("pending_changes"); pending.(bool) {
+		return d.SetNew("pending_changes", false)
+	}
+
+	return nil
+}
+
+// firewallApplyMutex serializes applies, since plesk_firewall and every
+// plesk_firewall_rule may apply the configuration in the same run and Plesk
+// handles one apply at a time.
+var firewallApplyMutex sync.Mutex
+
+// resourceFirewallApply saves the firewall settings and applies the staged
+// configuration.
+func resourceFirewallApply(ctx context.Context, d *schema.ResourceData, m interface{}, timeout time.Duration) diag.Diagnostics {
+	client := m.(*Client)
+
+	reqBody := map[string]interface{}{
+		"enabled":              d.Get("enabled").(bool),
+		"confirmation_timeout": d.Get("confirmation_timeout").(int),
+	}
+	if _, diags := client.Put(ctx, "/api/v2/extensions/firewall", reqBody); diags.HasError() {
+		return diags
+	}
+
+	return applyFirewallConfiguration(ctx, client, timeout)
+}
+
+// applyFirewallConfiguration applies the staged firewall configuration and
+// confirms it once Plesk has activated it.
+func applyFirewallConfiguration(ctx context.Context, client *Client, timeout time.Duration) diag.Diagnostics {
+	firewallApplyMutex.Lock()
+	defer firewallApplyMutex.Unlock()
+
+	deadline := time.Now().Add(timeout)
+
+	if _, diags := client.Post(ctx, "/api/v2/extensions/firewall/apply", nil); diags.HasError() {
+		return diags
+	}
+
+	// Poll quickly so that the confirmation is sent well within the window.
+	// The status can still show the outcome of the previous apply, so it
+	// only counts once this apply has been seen.
+	var status asyncStatus
+	diags := waitForAsyncOperation(ctx, client, asyncOperation{
+		Description: "firewall configuration apply",
+		Path:        "/api/v2/extensions/firewall/status",
+		Pending:     []string{"applying"},
+		Target:      []string{"awaiting_confirmation", "active"},
+		Failed:      []string{"failed", "rolled_back"},
+		Stale:       []string{"active", "failed", "rolled_back"},
+		Timeout:     time.Until(deadline),
+		Delay:       time.Second,
+		MinTimeout:  time.Second,
+	}, &status)
+	if diags.HasError() || status.Status == "active" {
+		return diags
+	}
+
+	if _, diags := client.Post(ctx, "/api/v2/extensions/firewall/confirm", nil); diags.HasError() {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Firewall changes could not be confirmed",
+			Detail:   "The new configuration may block access to the Plesk API. Plesk rolls it back once the confirmation timeout expires.",
+		})
+	}
+
+	return waitForAsyncOperation(ctx, client, asyncOperation{
+		Description: "firewall configuration confirmation",
+		Path:        "/api/v2/extensions/firewall/status",
+		Pending:     []string{"awaiting_confirmation"},
+		Target:      []string{"active"},
+		Failed:      []string{"failed", "rolled_back"},
+		Timeout:     time.Until(deadline),
+		Delay:       time.Second,
+		MinTimeout:  time.Second,
+	}, nil)
+}
@@ -0,0 +1,264 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var firewallPortPattern = regexp.MustCompile(`^(\d+)(?:-(\d+))?$`)
+
+// ResourceFirewallRule defines a rule of the Plesk Firewall extension.
+// Plesk stages rule changes until the configuration is applied. By default
+// every change is applied and confirmed right away, like plesk_firewall
+// does. With apply = false changes stay staged, so several rules can be
+// applied at once by plesk_firewall; list the rules in its triggers so that
+// the apply happens in the same run.
+func ResourceFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFirewallRuleCreate,
+		ReadContext:   resourceFirewallRuleRead,
+		UpdateContext: resourceFirewallRuleUpdate,
+		DeleteContext: resourceFirewallRuleDelete,
+		CustomizeDiff: resourceFirewallRuleCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the rule shown in the panel.",
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "inbound",
+				ValidateFunc: validation.StringInSlice([]string{"inbound", "outbound"}, false),
+				Description:  "Traffic direction the rule applies to (inbound or outbound).",
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"allow", "deny", "reject"}, false),
+				Description:  "What happens to matching traffic: allow, deny (drop silently) or reject.",
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "tcp",
+				ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "icmp", "any"}, false),
+				Description:  "Protocol the rule matches (tcp, udp, icmp or any).",
+			},
+			"ports": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateFirewallPort},
+				Description: "Ports or port ranges (e.g., 8080-8090) the rule matches. Only valid for tcp and udp. Omit to match all ports.",
+			},
+			"source_addresses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.Any(validation.IsIPAddress, validation.IsCIDR),
+				},
+				Description: "IP addresses or CIDR ranges traffic must come from. Omit to match any source.",
+			},
+			"destination_addresses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.Any(validation.IsIPAddress, validation.IsCIDR),
+				},
+				Description: "IP addresses or CIDR ranges traffic must be sent to. Omit to match any destination.",
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Position of the rule in the rule list; rules with lower values are evaluated first. Defaults to the end of the list.",
+			},
+			"apply": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to apply the firewall configuration as soon as the rule changes. Set to false to leave the change staged and apply it with plesk_firewall, listing the rule in its triggers.",
+			},
+		},
+	}
+}
+
+// firewallRuleBody mirrors the body of the /api/v2/extensions/firewall/rules endpoints.
+type firewallRuleBody struct {
+	ID                   string   `json:"id,omitempty"`
+	Name                 string   `json:"name"`
+	Direction            string   `json:"direction"`
+	Action               string   `json:"action"`
+	Protocol             string   `json:"protocol"`
+	Ports                []string `json:"ports"`
+	SourceAddresses      []string `json:"source_addresses"`
+	DestinationAddresses []string `json:"destination_addresses"`
+	Priority             int      `json:"priority,omitempty"`
+}
+
+func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, diags := client.Post(ctx, "/api/v2/extensions/firewall/rules", expandFirewallRule(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse firewall rule create response: %s", err)
+	}
+
+	d.SetId(resp.ID)
+
+	if d.Get("apply").(bool) {
+		if diags := applyFirewallConfiguration(ctx, client, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceFirewallRuleRead(ctx, d, m)
+}
+
+func resourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, found, diags := client.GetIfExists(ctx, fmt.Sprintf("/api/v2/extensions/firewall/rules/%s", d.Id()))
+	if diags.HasError() {
+		return diags
+	}
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	var rule firewallRuleBody
+	if err := json.Unmarshal(respBody, &rule); err != nil {
+		return diag.Errorf("failed to parse firewall rule read response: %s", err)
+	}
+
+	d.Set("name", rule.Name)
+	d.Set("direction", rule.Direction)
+	d.Set("action", rule.Action)
+	d.Set("protocol", rule.Protocol)
+	d.Set("ports", rule.Ports)
+	d.Set("source_addresses", rule.SourceAddresses)
+	d.Set("destination_addresses", rule.DestinationAddresses)
+	d.Set("priority", rule.Priority)
+	if _, ok := d.GetOkExists("apply"); !ok {
+		// apply is not stored by Plesk; default it on import.
+		d.Set("apply", true)
+	}
+
+	return nil
+}
+
+func resourceFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	path := fmt.Sprintf("/api/v2/extensions/firewall/rules/%s", d.Id())
+	if _, diags := client.Put(ctx, path, expandFirewallRule(d)); diags.HasError() {
+		return diags
+	}
+
+	if d.Get("apply").(bool) {
+		if diags := applyFirewallConfiguration(ctx, client, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceFirewallRuleRead(ctx, d, m)
+}
+
+func resourceFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if diags := client.Delete(ctx, fmt.Sprintf("/api/v2/extensions/firewall/rules/%s", d.Id())); diags.HasError() {
+		return diags
+	}
+
+	if d.Get("apply").(bool) {
+		return applyFirewallConfiguration(ctx, client, d.Timeout(schema.TimeoutDelete))
+	}
+
+	return nil
+}
+
+func resourceFirewallRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	protocol := d.Get("protocol").(string)
+	if protocol == "tcp" || protocol == "udp" || !d.NewValueKnown("ports") {
+		return nil
+	}
+
+	if ports := d.Get("ports").(*schema.Set); ports.Len() > 0 {
+		return fmt.Errorf("ports can only be used with the tcp and udp protocols, not %s", protocol)
+	}
+
+	return nil
+}
+
+func expandFirewallRule(d *schema.ResourceData) firewallRuleBody {
+	return firewallRuleBody{
+		Name:                 d.Get("name").(string),
+		Direction:            d.Get("direction").(string),
+		Action:               d.Get("action").(string),
+		Protocol:             d.Get("protocol").(string),
+		Ports:                expandStringSet(d.Get("ports").(*schema.Set)),
+		SourceAddresses:      expandStringSet(d.Get("source_addresses").(*schema.Set)),
+		DestinationAddresses: expandStringSet(d.Get("destination_addresses").(*schema.Set)),
+		Priority:             d.Get("priority").(int),
+	}
+}
+
+// validateFirewallPort accepts a port number or an ascending range of port
+// numbers such as 8080-8090.
+func validateFirewallPort(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	match := firewallPortPattern.FindStringSubmatch(v)
+	if match == nil {
+		return nil, []error{fmt.Errorf("%s: expected a port or port range such as 8080-8090, got %q", k, v)}
+	}
+
+	var ports []int
+	for _, s := range strings.Split(v, "-") {
+		port, _ := strconv.Atoi(s)
+		if port < 1 || port > 65535 {
+			return nil, []error{fmt.Errorf("%s: port %d is out of range 1-65535", k, port)}
+		}
+		ports = append(ports, port)
+	}
+	if len(ports) == 2 && ports[0] > ports[1] {
+		return nil, []error{fmt.Errorf("%s: port range %q must be ascending", k, v)}
+	}
+
+	return nil, nil
+}
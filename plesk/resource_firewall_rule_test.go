@@ -0,0 +1,32 @@
+package plesk
+
+import "testing"
+
+func TestValidateFirewallPort(t *testing.T) {
+	cases := []struct {
+		value   interface{}
+		wantErr bool
+	}{
+		{"22", false},
+		{"1", false},
+		{"65535", false},
+		{"8080-8090", false},
+		{"8080-8080", false},
+		{"0", true},
+		{"65536", true},
+		{"8090-8080", true},
+		{"1-70000", true},
+		{"http", true},
+		{"80,443", true},
+		{"80-", true},
+		{"", true},
+		{80, true},
+	}
+
+	for _, tc := range cases {
+		_, errs := validateFirewallPort(tc.value, "ports")
+		if (len(errs) > 0) != tc.wantErr {
+			t.Errorf("validateFirewallPort(%#v) errors = %v, wantErr %v", tc.value, errs, tc.wantErr)
+		}
+	}
+}
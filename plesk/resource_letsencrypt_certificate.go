@@ -0,0 +1,204 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceLetsEncryptCertificate issues a Let's Encrypt certificate for a
+// domain through the SSL It! extension and keeps the domain secured with it.
+func ResourceLetsEncryptCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLetsEncryptCertificateCreate,
+		ReadContext:   resourceLetsEncryptCertificateRead,
+		UpdateContext: resourceLetsEncryptCertificateUpdate,
+		DeleteContext: resourceLetsEncryptCertificateDelete,
+		CustomizeDiff: resourceLetsEncryptCertificateCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the domain to issue the certificate for.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Email address for Let's Encrypt expiry and security notifications.",
+			},
+			"include_www": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to include the www subdomain.",
+			},
+			"wildcard": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to issue a wildcard certificate (*.domain). Requires DNS served by Plesk.",
+			},
+			"secure_webmail": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to include webmail and secure it with the certificate.",
+			},
+			"secure_mail": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to secure mail services for the domain with the certificate.",
+			},
+			"certificate_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the issued certificate in the domain's repository.",
+			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiry of the issued certificate (RFC 3339).",
+			},
+		},
+	}
+}
+
+// letsEncryptStatus mirrors the body of GET /api/v2/domains/{id}/letsencrypt.
+type letsEncryptStatus struct {
+	Status        string `json:"status"`
+	Error         string `json:"error,omitempty"`
+	CertificateID string `json:"certificate_id"`
+	ExpiresAt     string `json:"expires_at"`
+	Email         string `json:"email"`
+	IncludeWWW    bool   `json:"include_www"`
+	Wildcard      bool   `json:"wildcard"`
+	SecureWebmail bool   `json:"secure_webmail"`
+	SecureMail    bool   `json:"secure_mail"`
+}
+
+func resourceLetsEncryptCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("domain_id").(string))
+
+	if diags := resourceLetsEncryptCertificateIssue(ctx, d, m, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceLetsEncryptCertificateRead(ctx, d, m)
+}
+
+func resourceLetsEncryptCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, diags := client.Get(ctx, fmt.Sprintf("/api/v2/domains/%s/letsencrypt", d.Id()))
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp letsEncryptStatus
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse Let's Encrypt status response: %s", err)
+	}
+
+	if resp.Status == "" || resp.Status == "none" {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("domain_id", d.Id())
+	d.Set("email", resp.Email)
+	d.Set("include_www", resp.IncludeWWW)
+	d.Set("wildcard", resp.Wildcard)
+	d.Set("secure_webmail", resp.SecureWebmail)
+	d.Set("secure_mail", resp.SecureMail)
+	d.Set("not_after", resp.ExpiresAt)
+
+	// A certificate replaced outside of Terraform is cleared here so that
+	// CustomizeDiff plans a re-issue in place instead of a replacement.
+	if current := d.Get("certificate_id").(string); current != "" && current != resp.CertificateID {
+		log.Printf("[WARN] Let's Encrypt certificate for domain %s was replaced out-of-band (%s -> %s)", d.Id(), current, resp.CertificateID)
+		d.Set("certificate_id", "")
+		return nil
+	}
+	d.Set("certificate_id", resp.CertificateID)
+
+	return nil
+}
+
+func resourceLetsEncryptCertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := resourceLetsEncryptCertificateIssue(ctx, d, m, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+		return diags
+	}
+
+	return resourceLetsEncryptCertificateRead(ctx, d, m)
+}
+
+func resourceLetsEncryptCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	return client.Delete(ctx, fmt.Sprintf("/api/v2/domains/%s/letsencrypt", d.Id()))
+}
+
+func resourceLetsEncryptCertificateCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if old, _ := d.GetChange("certificate_id"); old.(string) == "" {
+		return d.SetNewComputed("certificate_id")
+	}
+
+	return nil
+}
+
+// resourceLetsEncryptCertificateIssue requests a certificate and waits until
+// Let's Encrypt has issued it or the timeout expires.
+func resourceLetsEncryptCertificateIssue(ctx context.Context, d *schema.ResourceData, m interface{}, timeout time.Duration) diag.Diagnostics {
+	client := m.(*Client)
+	path := fmt.Sprintf("/api/v2/domains/%s/letsencrypt", d.Id())
+
+	reqBody := map[string]interface{}{
+		"email":          d.Get("email").(string),
+		"include_www":    d.Get("include_www").(bool),
+		"wildcard":       d.Get("wildcard").(bool),
+		"secure_webmail": d.Get("secure_webmail").(bool),
+		"secure_mail":    d.Get("secure_mail").(bool),
+	}
+
+	if _, diags := client.Post(ctx, path, reqBody); diags.HasError() {
+		return diags
+	}
+
+	var status letsEncryptStatus
+	diags := waitForAsyncOperation(ctx, client, asyncOperation{
+		Description: fmt.Sprintf("Let's Encrypt certificate issuance for domain %s", d.Id()),
+		Path:        path,
+		Pending:     []string{"pending", "issuing"},
+		Target:      []string{"issued"},
+		Failed:      []string{"failed"},
+		Timeout:     timeout,
+		Delay:       5 * time.Second,
+	}, &status)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Set("certificate_id", status.CertificateID)
+	return nil
+}
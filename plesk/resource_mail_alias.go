@@ -0,0 +1,116 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceMailAlias defines an alias address of an existing mail name
+// (mailbox or forwarder). The resource ID is "<mail_name>/<address>".
+func ResourceMailAlias() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMailAliasCreate,
+		ReadContext:   resourceMailAliasRead,
+		DeleteContext: resourceMailAliasDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"mail_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Full address of the mail name that owns the alias (e.g., info@example.com).",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Full alias address (e.g., contact@example.com).",
+			},
+		},
+	}
+}
+
+func resourceMailAliasCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	mailName := d.Get("mail_name").(string)
+	address := d.Get("address").(string)
+
+	reqBody := map[string]interface{}{
+		"address": address,
+	}
+
+	path := fmt.Sprintf("/api/v2/mail/%s/aliases", url.PathEscape(mailName))
+	if _, diags := client.Post(ctx, path, reqBody); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", mailName, address))
+	return resourceMailAliasRead(ctx, d, m)
+}
+
+func resourceMailAliasRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	mailName, address, err := parseMailAliasID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	respBody, diags := client.Get(ctx, fmt.Sprintf("/api/v2/mail/%s/aliases", url.PathEscape(mailName)))
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp struct {
+		Aliases []string `json:"aliases"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse mail aliases response: %s", err)
+	}
+
+	// Addresses are case-insensitive; keep the configured spelling so that
+	// Plesk lowercasing it does not force a replacement.
+	for _, alias := range resp.Aliases {
+		if strings.EqualFold(alias, address) {
+			d.Set("mail_name", mailName)
+			if current := d.Get("address").(string); !strings.EqualFold(current, alias) {
+				d.Set("address", alias)
+			}
+			return nil
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceMailAliasDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	mailName, address, err := parseMailAliasID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return client.Delete(ctx, fmt.Sprintf("/api/v2/mail/%s/aliases/%s", url.PathEscape(mailName), url.PathEscape(address)))
+}
+
+// parseMailAliasID splits an alias ID into the owning mail name and the alias address.
+func parseMailAliasID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected mail alias ID %q, expected <mail_name>/<address>", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,68 @@
+package plesk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceMailAliasRead(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"aliases":["sales@example.com"]}`))
+	})
+
+	cases := []struct {
+		name        string
+		config      map[string]interface{}
+		id          string
+		wantID      string
+		wantAddress string
+	}{
+		{
+			name:        "same spelling",
+			config:      map[string]interface{}{"mail_name": "info@example.com", "address": "sales@example.com"},
+			id:          "info@example.com/sales@example.com",
+			wantID:      "info@example.com/sales@example.com",
+			wantAddress: "sales@example.com",
+		},
+		{
+			name:        "configured spelling is kept",
+			config:      map[string]interface{}{"mail_name": "info@example.com", "address": "Sales@Example.com"},
+			id:          "info@example.com/Sales@Example.com",
+			wantID:      "info@example.com/Sales@Example.com",
+			wantAddress: "Sales@Example.com",
+		},
+		{
+			name:        "import",
+			config:      map[string]interface{}{},
+			id:          "info@example.com/SALES@example.com",
+			wantID:      "info@example.com/SALES@example.com",
+			wantAddress: "sales@example.com",
+		},
+		{
+			name:   "alias removed",
+			config: map[string]interface{}{"mail_name": "info@example.com", "address": "support@example.com"},
+			id:     "info@example.com/support@example.com",
+			wantID: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceMailAlias().Schema, tc.config)
+			d.SetId(tc.id)
+
+			if diags := resourceMailAliasRead(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("resourceMailAliasRead() diags = %v", diags)
+			}
+			if d.Id() != tc.wantID {
+				t.Errorf("ID = %q, want %q", d.Id(), tc.wantID)
+			}
+			if tc.wantID != "" && d.Get("address").(string) != tc.wantAddress {
+				t.Errorf("address = %q, want %q", d.Get("address"), tc.wantAddress)
+			}
+		})
+	}
+}
@@ -0,0 +1,554 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// spfAllQualifiers maps the spf.all attribute to the qualifier of the SPF "all" mechanism.
+var spfAllQualifiers = map[string]string{
+	"pass":     "+",
+	"neutral":  "?",
+	"softfail": "~",
+	"fail":     "-",
+}
+
+// ResourceMailAuthentication manages DKIM signing and the SPF and DMARC
+// records of a domain. SPF and DMARC are kept as TXT records in the
+// domain's zone and are rendered from structured attributes.
+func ResourceMailAuthentication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMailAuthenticationCreate,
+		ReadContext:   resourceMailAuthenticationRead,
+		UpdateContext: resourceMailAuthenticationUpdate,
+		DeleteContext: resourceMailAuthenticationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the domain to configure.",
+			},
+			"dkim_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether outgoing mail is signed with DKIM. Omit to leave the current setting untouched. This is the same setting as dkim_signing of plesk_mail_settings, so do not set both.",
+			},
+			"dkim_selector": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Selector of the DKIM key.",
+			},
+			"dkim_public_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Public part of the generated DKIM key, as published in DNS.",
+			},
+			"spf": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "SPF policy of the domain. Omit to leave the existing SPF record untouched.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"a": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the domain's A/AAAA addresses may send mail.",
+						},
+						"a_hosts": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Other hosts whose A/AAAA addresses may send mail (a:<host> mechanisms).",
+						},
+						"mx": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the domain's MX hosts may send mail.",
+						},
+						"mx_hosts": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Other domains whose MX hosts may send mail (mx:<domain> mechanisms).",
+						},
+						"ip4": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.Any(validation.IsIPv4Address, validation.IsCIDR)},
+							Description: "IPv4 addresses or networks allowed to send mail.",
+						},
+						"ip6": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.Any(validation.IsIPv6Address, validation.IsCIDR)},
+							Description: "IPv6 addresses or networks allowed to send mail.",
+						},
+						"include": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Domains whose SPF policy is included (e.g., _spf.google.com).",
+						},
+						"exists": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Domain specifications of exists mechanisms (e.g., %{i}._spf.example.com).",
+						},
+						"ptr": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the ptr mechanism is used. Its use is discouraged by RFC 7208.",
+						},
+						"extra_terms": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Other terms kept verbatim, such as mechanisms with a -, ~ or ? qualifier or the exp modifier. They are rendered in order after the mechanisms above and before all.",
+						},
+						"all": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "softfail",
+							ValidateFunc: validation.StringInSlice([]string{"pass", "neutral", "softfail", "fail", ""}, false),
+							Description:  "Result for senders not matched by any mechanism (pass, neutral, softfail or fail). Set to an empty string to omit the all mechanism, e.g. when redirect is used.",
+						},
+						"redirect": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Domain whose SPF policy applies when no mechanism matches (redirect modifier).",
+						},
+					},
+				},
+			},
+			"spf_record": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Rendered SPF TXT record.",
+			},
+			"dmarc": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "DMARC policy of the domain. Omit to leave the existing DMARC record untouched.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"none", "quarantine", "reject"}, false),
+							Description:  "Policy for mail failing DMARC (none, quarantine or reject).",
+						},
+						"subdomain_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"none", "quarantine", "reject"}, false),
+							Description:  "Policy for subdomains. Defaults to policy when omitted.",
+						},
+						"rua": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Addresses receiving aggregate reports.",
+						},
+						"ruf": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Addresses receiving forensic reports.",
+						},
+						"pct": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      100,
+							ValidateFunc: validation.IntBetween(0, 100),
+							Description:  "Percentage of failing mail the policy is applied to.",
+						},
+						"extra_tags": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Other tags kept verbatim in tag=value form, such as adkim=s, aspf=r, fo=1 or ri=86400. They are rendered in order after pct.",
+						},
+					},
+				},
+			},
+			"dmarc_record": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Rendered DMARC TXT record.",
+			},
+		},
+	}
+}
+
+func resourceMailAuthenticationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("domain_id").(string))
+
+	if diags := resourceMailAuthenticationApply(ctx, d, m); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceMailAuthenticationRead(ctx, d, m)
+}
+
+func resourceMailAuthenticationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, diags := client.Get(ctx, fmt.Sprintf("/api/v2/domains/%s/dkim", d.Id()))
+	if diags.HasError() {
+		return diags
+	}
+
+	var dkim struct {
+		Enabled   bool   `json:"enabled"`
+		Selector  string `json:"selector"`
+		PublicKey string `json:"public_key"`
+	}
+	if err := json.Unmarshal(respBody, &dkim); err != nil {
+		return diag.Errorf("failed to parse DKIM response: %s", err)
+	}
+
+	spfRecord, dmarcRecord, diags := findMailAuthenticationRecords(ctx, client, d.Id())
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Set("domain_id", d.Id())
+	d.Set("dkim_enabled", dkim.Enabled)
+	d.Set("dkim_selector", dkim.Selector)
+	d.Set("dkim_public_key", dkim.PublicKey)
+
+	d.Set("spf_record", "")
+	d.Set("spf", nil)
+	if spfRecord != nil {
+		d.Set("spf_record", spfRecord.Value)
+		d.Set("spf", []interface{}{flattenSPF(spfRecord.Value)})
+	}
+
+	d.Set("dmarc_record", "")
+	d.Set("dmarc", nil)
+	if dmarcRecord != nil {
+		d.Set("dmarc_record", dmarcRecord.Value)
+		d.Set("dmarc", []interface{}{flattenDMARC(dmarcRecord.Value)})
+	}
+
+	return nil
+}
+
+func resourceMailAuthenticationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := resourceMailAuthenticationApply(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return resourceMailAuthenticationRead(ctx, d, m)
+}
+
+func resourceMailAuthenticationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Mail authentication cannot be removed from a domain, only changed.
+	// Dropping the resource leaves DKIM and the SPF and DMARC records in place.
+	d.SetId("")
+	return nil
+}
+
+func resourceMailAuthenticationApply(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	domainID := d.Id()
+
+	// DKIM is only switched when configured, so that it can be left to the
+	// panel or other tooling.
+	if raw := d.GetRawConfig(); !raw.IsNull() && !raw.GetAttr("dkim_enabled").IsNull() {
+		reqBody := map[string]interface{}{
+			"enabled": d.Get("dkim_enabled").(bool),
+		}
+		if _, diags := client.Put(ctx, fmt.Sprintf("/api/v2/domains/%s/dkim", domainID), reqBody); diags.HasError() {
+			return diags
+		}
+	}
+
+	spfRecord, dmarcRecord, diags := findMailAuthenticationRecords(ctx, client, domainID)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v, ok := d.GetOk("spf"); ok {
+		value := expandSPF(v.([]interface{})[0].(map[string]interface{}))
+		if diags := upsertTxtRecord(ctx, client, domainID, spfRecord, "", value); diags.HasError() {
+			return diags
+		}
+	}
+
+	if v, ok := d.GetOk("dmarc"); ok {
+		value := expandDMARC(v.([]interface{})[0].(map[string]interface{}))
+		if diags := upsertTxtRecord(ctx, client, domainID, dmarcRecord, "_dmarc", value); diags.HasError() {
+			return diags
+		}
+	}
+
+	return nil
+}
+
+// findMailAuthenticationRecords returns the SPF and DMARC TXT records of a
+// domain's zone, or nil for the ones that do not exist.
+func findMailAuthenticationRecords(ctx context.Context, client *Client, domainID string) (*dnsRecord, *dnsRecord, diag.Diagnostics) {
+	domainName, diags := getDomainName(ctx, client, domainID)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	records, diags := listDnsZoneRecords(ctx, client, domainID, domainName, false, nil)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	var spfRecord, dmarcRecord *dnsRecord
+	for i := range records {
+		r := &records[i]
+		if r.Type != "TXT" {
+			continue
+		}
+
+		value := unquoteTxtRecordValue(r.Value)
+		host := normalizeDnsRecordHost(r.Host, domainName)
+		switch {
+		case spfRecord == nil && host == normalizeDnsRecordHost("", domainName) && strings.HasPrefix(value, "v=spf1"):
+			r.Value = value
+			spfRecord = r
+		case dmarcRecord == nil && host == normalizeDnsRecordHost("_dmarc", domainName) && strings.HasPrefix(value, "v=DMARC1"):
+			r.Value = value
+			dmarcRecord = r
+		}
+	}
+
+	return spfRecord, dmarcRecord, nil
+}
+
+// upsertTxtRecord updates an existing TXT record, or creates it when existing is nil.
+func upsertTxtRecord(ctx context.Context, client *Client, domainID string, existing *dnsRecord, host, value string) diag.Diagnostics {
+	if existing == nil {
+		record := dnsRecord{Type: "TXT", Host: host, Value: value, TTL: 3600}
+		_, diags := client.Post(ctx, fmt.Sprintf("/api/v2/domains/%s/dns/records", domainID), record)
+		return diags
+	}
+
+	if existing.Value == value {
+		return nil
+	}
+
+	record := *existing
+	record.ID = ""
+	record.Value = value
+	_, diags := client.Put(ctx, fmt.Sprintf("/api/v2/domains/%s/dns/records/%s", domainID, existing.ID), record)
+	return diags
+}
+
+// expandSPF renders the spf block as an SPF record. Mechanisms are emitted
+// in a fixed order: a, mx, ip4, ip6, include, exists, ptr, extra_terms,
+// all, then the redirect modifier.
+func expandSPF(spf map[string]interface{}) string {
+	terms := []string{"v=spf1"}
+	if spf["a"].(bool) {
+		terms = append(terms, "a")
+	}
+	for _, host := range spf["a_hosts"].([]interface{}) {
+		terms = append(terms, "a:"+host.(string))
+	}
+	if spf["mx"].(bool) {
+		terms = append(terms, "mx")
+	}
+	for _, host := range spf["mx_hosts"].([]interface{}) {
+		terms = append(terms, "mx:"+host.(string))
+	}
+	for _, ip := range spf["ip4"].([]interface{}) {
+		terms = append(terms, "ip4:"+ip.(string))
+	}
+	for _, ip := range spf["ip6"].([]interface{}) {
+		terms = append(terms, "ip6:"+ip.(string))
+	}
+	for _, include := range spf["include"].([]interface{}) {
+		terms = append(terms, "include:"+include.(string))
+	}
+	for _, exists := range spf["exists"].([]interface{}) {
+		terms = append(terms, "exists:"+exists.(string))
+	}
+	if spf["ptr"].(bool) {
+		terms = append(terms, "ptr")
+	}
+	for _, term := range spf["extra_terms"].([]interface{}) {
+		terms = append(terms, term.(string))
+	}
+	if all := spf["all"].(string); all != "" {
+		terms = append(terms, spfAllQualifiers[all]+"all")
+	}
+	if redirect := spf["redirect"].(string); redirect != "" {
+		terms = append(terms, "redirect="+redirect)
+	}
+
+	return strings.Join(terms, " ")
+}
+
+// flattenSPF parses an SPF record into the spf block. Terms that the block
+// does not model, including mechanisms with a qualifier other than +, are
+// kept verbatim in extra_terms so that expandSPF never drops them.
+func flattenSPF(value string) map[string]interface{} {
+	spf := map[string]interface{}{
+		"a":           false,
+		"a_hosts":     []interface{}{},
+		"mx":          false,
+		"mx_hosts":    []interface{}{},
+		"ip4":         []interface{}{},
+		"ip6":         []interface{}{},
+		"include":     []interface{}{},
+		"exists":      []interface{}{},
+		"ptr":         false,
+		"extra_terms": []interface{}{},
+		"all":         "",
+		"redirect":    "",
+	}
+
+	appendTo := func(key, v string) {
+		spf[key] = append(spf[key].([]interface{}), v)
+	}
+
+	for _, term := range strings.Fields(value)[1:] {
+		lower := strings.ToLower(term)
+		mechanism := strings.TrimPrefix(lower, "+")
+		arg := term[len(term)-len(mechanism):]
+		if i := strings.IndexAny(arg, ":="); i >= 0 {
+			arg = arg[i+1:]
+		}
+
+		switch {
+		case strings.HasPrefix(lower, "redirect="):
+			spf["redirect"] = arg
+		case strings.HasSuffix(lower, "all") && strings.Trim(lower, "+-~?") == "all":
+			qualifier := strings.TrimSuffix(lower, "all")
+			if qualifier == "" {
+				qualifier = "+"
+			}
+			for name, q := range spfAllQualifiers {
+				if q == qualifier {
+					spf["all"] = name
+				}
+			}
+		case mechanism == "a":
+			spf["a"] = true
+		case mechanism == "mx":
+			spf["mx"] = true
+		case mechanism == "ptr":
+			spf["ptr"] = true
+		case strings.HasPrefix(mechanism, "a:"):
+			appendTo("a_hosts", arg)
+		case strings.HasPrefix(mechanism, "mx:"):
+			appendTo("mx_hosts", arg)
+		case strings.HasPrefix(mechanism, "ip4:"):
+			appendTo("ip4", arg)
+		case strings.HasPrefix(mechanism, "ip6:"):
+			appendTo("ip6", arg)
+		case strings.HasPrefix(mechanism, "include:"):
+			appendTo("include", arg)
+		case strings.HasPrefix(mechanism, "exists:"):
+			appendTo("exists", arg)
+		default:
+			appendTo("extra_terms", term)
+		}
+	}
+
+	return spf
+}
+
+func expandDMARC(dmarc map[string]interface{}) string {
+	tags := []string{"v=DMARC1", "p=" + dmarc["policy"].(string)}
+	if sp := dmarc["subdomain_policy"].(string); sp != "" {
+		tags = append(tags, "sp="+sp)
+	}
+	if rua := dmarc["rua"].([]interface{}); len(rua) > 0 {
+		tags = append(tags, "rua="+joinMailtoURIs(rua))
+	}
+	if ruf := dmarc["ruf"].([]interface{}); len(ruf) > 0 {
+		tags = append(tags, "ruf="+joinMailtoURIs(ruf))
+	}
+	tags = append(tags, fmt.Sprintf("pct=%d", dmarc["pct"].(int)))
+	for _, tag := range dmarc["extra_tags"].([]interface{}) {
+		tags = append(tags, tag.(string))
+	}
+
+	return strings.Join(tags, "; ")
+}
+
+func flattenDMARC(value string) map[string]interface{} {
+	dmarc := map[string]interface{}{
+		"policy":           "none",
+		"subdomain_policy": "",
+		"rua":              []interface{}{},
+		"ruf":              []interface{}{},
+		"pct":              100,
+		"extra_tags":       []interface{}{},
+	}
+
+	for _, tag := range strings.Split(value, ";") {
+		tag = strings.TrimSpace(tag)
+		parts := strings.SplitN(tag, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		switch parts[0] {
+		case "v":
+		case "p":
+			dmarc["policy"] = parts[1]
+		case "sp":
+			dmarc["subdomain_policy"] = parts[1]
+		case "rua", "ruf":
+			dmarc[parts[0]] = splitMailtoURIs(parts[1])
+		case "pct":
+			if pct, err := strconv.Atoi(parts[1]); err == nil {
+				dmarc["pct"] = pct
+			}
+		default:
+			// Keep tags the schema does not model, so that rewriting the
+			// record does not drop them.
+			dmarc["extra_tags"] = append(dmarc["extra_tags"].([]interface{}), tag)
+		}
+	}
+
+	return dmarc
+}
+
+func joinMailtoURIs(addresses []interface{}) string {
+	uris := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		uris = append(uris, "mailto:"+strings.TrimPrefix(address.(string), "mailto:"))
+	}
+
+	return strings.Join(uris, ",")
+}
+
+func splitMailtoURIs(value string) []interface{} {
+	addresses := []interface{}{}
+	for _, uri := range strings.Split(value, ",") {
+		addresses = append(addresses, strings.TrimPrefix(strings.TrimSpace(uri), "mailto:"))
+	}
+
+	return addresses
+}
@@ -0,0 +1,180 @@
+package plesk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlattenSPF(t *testing.T) {
+	cases := []struct {
+		value string
+		want  map[string]interface{}
+	}{
+		{
+			value: "v=spf1 a mx ip4:192.0.2.0/24 include:_spf.google.com ~all",
+			want: spfBlock(map[string]interface{}{
+				"a":       true,
+				"mx":      true,
+				"ip4":     []interface{}{"192.0.2.0/24"},
+				"include": []interface{}{"_spf.google.com"},
+				"all":     "softfail",
+			}),
+		},
+		{
+			value: "v=spf1 a:mail.example.com exists:%{i}.x redirect=_spf.example.com",
+			want: spfBlock(map[string]interface{}{
+				"a_hosts":  []interface{}{"mail.example.com"},
+				"exists":   []interface{}{"%{i}.x"},
+				"redirect": "_spf.example.com",
+			}),
+		},
+		{
+			value: "v=spf1 +mx mx:backup.example.net ip6:2001:db8::/32 ptr -all",
+			want: spfBlock(map[string]interface{}{
+				"mx":       true,
+				"mx_hosts": []interface{}{"backup.example.net"},
+				"ip6":      []interface{}{"2001:db8::/32"},
+				"ptr":      true,
+				"all":      "fail",
+			}),
+		},
+		{
+			value: "v=spf1 -ip4:192.0.2.1 a/24 ?include:example.org exp=explain.example.com +all",
+			want: spfBlock(map[string]interface{}{
+				"extra_terms": []interface{}{"-ip4:192.0.2.1", "a/24", "?include:example.org", "exp=explain.example.com"},
+				"all":         "pass",
+			}),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.value, func(t *testing.T) {
+			got := flattenSPF(tc.value)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("flattenSPF(%q)\n got  %v\n want %v", tc.value, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestExpandSPFRoundTrip(t *testing.T) {
+	// Records whose terms are already in expandSPF's order must survive a
+	// read and re-render unchanged.
+	values := []string{
+		"v=spf1 a mx ip4:192.0.2.0/24 include:_spf.google.com ~all",
+		"v=spf1 a:mail.example.com exists:%{i}.x redirect=_spf.example.com",
+		"v=spf1 mx mx:backup.example.net ip6:2001:db8::/32 ptr -all",
+		"v=spf1 -ip4:192.0.2.1 a/24 ?include:example.org exp=explain.example.com +all",
+		"v=spf1 -all",
+	}
+
+	for _, value := range values {
+		if got := expandSPF(flattenSPF(value)); got != value {
+			t.Errorf("expandSPF(flattenSPF(%q)) = %q", value, got)
+		}
+	}
+}
+
+func TestFlattenDMARC(t *testing.T) {
+	cases := []struct {
+		value string
+		want  map[string]interface{}
+	}{
+		{
+			value: "v=DMARC1; p=reject; sp=quarantine; rua=mailto:dmarc@example.com,mailto:ops@example.com; pct=50",
+			want: map[string]interface{}{
+				"policy":           "reject",
+				"subdomain_policy": "quarantine",
+				"rua":              []interface{}{"dmarc@example.com", "ops@example.com"},
+				"ruf":              []interface{}{},
+				"pct":              50,
+				"extra_tags":       []interface{}{},
+			},
+		},
+		{
+			value: "v=DMARC1;p=none",
+			want: map[string]interface{}{
+				"policy":           "none",
+				"subdomain_policy": "",
+				"rua":              []interface{}{},
+				"ruf":              []interface{}{},
+				"pct":              100,
+				"extra_tags":       []interface{}{},
+			},
+		},
+		{
+			value: "v=DMARC1; p=quarantine; adkim=s; aspf=r; fo=1; ri=86400",
+			want: map[string]interface{}{
+				"policy":           "quarantine",
+				"subdomain_policy": "",
+				"rua":              []interface{}{},
+				"ruf":              []interface{}{},
+				"pct":              100,
+				"extra_tags":       []interface{}{"adkim=s", "aspf=r", "fo=1", "ri=86400"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		got := flattenDMARC(tc.value)
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("flattenDMARC(%q)\n got  %v\n want %v", tc.value, got, tc.want)
+		}
+	}
+}
+
+func TestExpandDMARC(t *testing.T) {
+	dmarc := map[string]interface{}{
+		"policy":           "quarantine",
+		"subdomain_policy": "",
+		"rua":              []interface{}{"dmarc@example.com", "mailto:ops@example.com"},
+		"ruf":              []interface{}{},
+		"pct":              100,
+		"extra_tags":       []interface{}{"adkim=s"},
+	}
+
+	want := "v=DMARC1; p=quarantine; rua=mailto:dmarc@example.com,mailto:ops@example.com; pct=100; adkim=s"
+	if got := expandDMARC(dmarc); got != want {
+		t.Errorf("expandDMARC() = %q, want %q", got, want)
+	}
+}
+
+func TestExpandDMARCRoundTrip(t *testing.T) {
+	// Records whose tags are already in expandDMARC's order must survive a
+	// read and re-render unchanged.
+	values := []string{
+		"v=DMARC1; p=reject; pct=100",
+		"v=DMARC1; p=reject; sp=quarantine; rua=mailto:dmarc@example.com,mailto:ops@example.com; pct=50",
+		"v=DMARC1; p=none; ruf=mailto:forensic@example.com; pct=100; adkim=s; aspf=r; fo=1; rf=afrf; ri=86400",
+	}
+
+	for _, value := range values {
+		if got := expandDMARC(flattenDMARC(value)); got != value {
+			t.Errorf("expandDMARC(flattenDMARC(%q)) = %q", value, got)
+		}
+	}
+}
+
+// spfBlock returns the flattened spf block with the given fields set and
+// all others at their empty values.
+func spfBlock(fields map[string]interface{}) map[string]interface{} {
+	spf := map[string]interface{}{
+		"a":           false,
+		"a_hosts":     []interface{}{},
+		"mx":          false,
+		"mx_hosts":    []interface{}{},
+		"ip4":         []interface{}{},
+		"ip6":         []interface{}{},
+		"include":     []interface{}{},
+		"exists":      []interface{}{},
+		"ptr":         false,
+		"extra_terms": []interface{}{},
+		"all":         "",
+		"redirect":    "",
+	}
+	for k, v := range fields {
+		spf[k] = v
+	}
+
+	return spf
+}
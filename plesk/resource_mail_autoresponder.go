@@ -0,0 +1,206 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceMailAutoresponder defines the auto-reply of a mailbox. A mailbox has
+// at most one autoresponder, so the resource ID is the mailbox address.
+func ResourceMailAutoresponder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMailAutoresponderCreate,
+		ReadContext:   resourceMailAutoresponderRead,
+		UpdateContext: resourceMailAutoresponderUpdate,
+		DeleteContext: resourceMailAutoresponderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"mailbox": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Full address of the mailbox the autoresponder belongs to.",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Re: <request_subject>",
+				Description: "Subject of the reply. <request_subject> is replaced with the original subject.",
+			},
+			"body": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Text of the reply.",
+			},
+			"content_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "text/plain",
+				ValidateFunc: validation.StringInSlice([]string{"text/plain", "text/html"}, false),
+				Description:  "Format of the body (text/plain or text/html).",
+			},
+			"reply_to": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Reply-To address of the reply.",
+			},
+			"frequency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 1000),
+				Description:  "Maximum number of replies per day to the same sender.",
+			},
+			"forward_to": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Address the original message is forwarded to.",
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Time after which the autoresponder is switched off (RFC 3339).",
+			},
+			"attachment": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Files attached to the reply.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the attached file.",
+						},
+						"content": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsBase64,
+							Description:  "Base64-encoded file content.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// mailAutoresponderBody mirrors the body of the /api/v2/mail/{mailbox}/autoresponder endpoint.
+type mailAutoresponderBody struct {
+	Enabled     bool                          `json:"enabled"`
+	Subject     string                        `json:"subject"`
+	Body        string                        `json:"body"`
+	ContentType string                        `json:"content_type"`
+	ReplyTo     string                        `json:"reply_to,omitempty"`
+	Frequency   int                           `json:"frequency"`
+	ForwardTo   string                        `json:"forward_to,omitempty"`
+	EndDate     string                        `json:"end_date,omitempty"`
+	Attachments []mailAutoresponderAttachment `json:"attachments"`
+}
+
+type mailAutoresponderAttachment struct {
+	FileName string `json:"file_name"`
+	Content  string `json:"content"`
+}
+
+func resourceMailAutoresponderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("mailbox").(string))
+
+	if diags := resourceMailAutoresponderPut(ctx, d, m); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceMailAutoresponderRead(ctx, d, m)
+}
+
+func resourceMailAutoresponderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, diags := client.Get(ctx, fmt.Sprintf("/api/v2/mail/%s/autoresponder", url.PathEscape(d.Id())))
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp mailAutoresponderBody
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse mail autoresponder response: %s", err)
+	}
+
+	// The autoresponder was switched off outside of Terraform
+	if !resp.Enabled {
+		d.SetId("")
+		return nil
+	}
+
+	attachments := make([]map[string]interface{}, 0, len(resp.Attachments))
+	for _, attachment := range resp.Attachments {
+		attachments = append(attachments, map[string]interface{}{
+			"file_name": attachment.FileName,
+			"content":   attachment.Content,
+		})
+	}
+
+	d.Set("mailbox", d.Id())
+	d.Set("subject", resp.Subject)
+	d.Set("body", resp.Body)
+	d.Set("content_type", resp.ContentType)
+	d.Set("reply_to", resp.ReplyTo)
+	d.Set("frequency", resp.Frequency)
+	d.Set("forward_to", resp.ForwardTo)
+	d.Set("end_date", resp.EndDate)
+	d.Set("attachment", attachments)
+
+	return nil
+}
+
+func resourceMailAutoresponderUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := resourceMailAutoresponderPut(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return resourceMailAutoresponderRead(ctx, d, m)
+}
+
+func resourceMailAutoresponderDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	return client.Delete(ctx, fmt.Sprintf("/api/v2/mail/%s/autoresponder", url.PathEscape(d.Id())))
+}
+
+func resourceMailAutoresponderPut(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	reqBody := mailAutoresponderBody{
+		Enabled:     true,
+		Subject:     d.Get("subject").(string),
+		Body:        d.Get("body").(string),
+		ContentType: d.Get("content_type").(string),
+		ReplyTo:     d.Get("reply_to").(string),
+		Frequency:   d.Get("frequency").(int),
+		ForwardTo:   d.Get("forward_to").(string),
+		EndDate:     d.Get("end_date").(string),
+		Attachments: []mailAutoresponderAttachment{},
+	}
+
+	for _, v := range d.Get("attachment").([]interface{}) {
+		attachment := v.(map[string]interface{})
+		reqBody.Attachments = append(reqBody.Attachments, mailAutoresponderAttachment{
+			FileName: attachment["file_name"].(string),
+			Content:  attachment["content"].(string),
+		})
+	}
+
+	_, diags := client.Put(ctx, fmt.Sprintf("/api/v2/mail/%s/autoresponder", url.PathEscape(d.Id())), reqBody)
+	return diags
+}
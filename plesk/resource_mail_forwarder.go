@@ -0,0 +1,123 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceMailForwarder defines a forward-only mail address, i.e. a mail name
+// without mailbox storage that redirects incoming mail to other addresses.
+func ResourceMailForwarder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMailForwarderCreate,
+		ReadContext:   resourceMailForwarderRead,
+		UpdateContext: resourceMailForwarderUpdate,
+		DeleteContext: resourceMailForwarderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Full mail address of the forwarder (e.g., sales@example.com).",
+			},
+			"targets": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Addresses incoming mail is forwarded to.",
+			},
+		},
+	}
+}
+
+func resourceMailForwarderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	reqBody := map[string]interface{}{
+		"address": d.Get("address").(string),
+		"targets": d.Get("targets").(*schema.Set).List(),
+	}
+
+	respBody, diags := client.Post(ctx, "/api/v2/mail/forwarders", reqBody)
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse mail forwarder create response: %s", err)
+	}
+
+	if resp.ID != "" {
+		d.SetId(resp.ID)
+	} else {
+		d.SetId(d.Get("address").(string))
+	}
+
+	return resourceMailForwarderRead(ctx, d, m)
+}
+
+func resourceMailForwarderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, diags := client.Get(ctx, "/api/v2/mail/forwarders")
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp struct {
+		Forwarders []struct {
+			ID      string   `json:"id"`
+			Address string   `json:"address"`
+			Targets []string `json:"targets"`
+		} `json:"forwarders"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse mail forwarders response: %s", err)
+	}
+
+	for _, forwarder := range resp.Forwarders {
+		if forwarder.ID == d.Id() || forwarder.Address == d.Id() {
+			d.Set("address", forwarder.Address)
+			d.Set("targets", forwarder.Targets)
+			return nil
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceMailForwarderUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	reqBody := map[string]interface{}{
+		"targets": d.Get("targets").(*schema.Set).List(),
+	}
+
+	path := fmt.Sprintf("/api/v2/mail/forwarders/%s", url.PathEscape(d.Id()))
+	_, diags := client.Put(ctx, path, reqBody)
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceMailForwarderRead(ctx, d, m)
+}
+
+func resourceMailForwarderDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	return client.Delete(ctx, fmt.Sprintf("/api/v2/mail/forwarders/%s", url.PathEscape(d.Id())))
+}
@@ -0,0 +1,215 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceMailSettings defines the per-domain mail settings resource schema and CRUD operations.
+// dkim_signing toggles the same setting as dkim_enabled of
+// plesk_mail_authentication; only set it in one of the two resources.
+func ResourceMailSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMailSettingsCreate,
+		ReadContext:   resourceMailSettingsRead,
+		UpdateContext: resourceMailSettingsUpdate,
+		DeleteContext: resourceMailSettingsDelete,
+		CustomizeDiff: resourceMailSettingsCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the domain the mail settings apply to.",
+			},
+			"mail_service": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the mail service is enabled for the domain.",
+			},
+			"webmail": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "roundcube",
+				ValidateFunc: validation.StringInSlice([]string{"roundcube", "horde", "none"}, false),
+				Description:  "Webmail application for the domain (roundcube, horde or none).",
+			},
+			"catch_all_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "reject",
+				ValidateFunc: validation.StringInSlice([]string{"reject", "bounce", "forward"}, false),
+				Description:  "What to do with mail sent to nonexistent addresses (reject, bounce or forward).",
+			},
+			"catch_all_bounce_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Message returned to the sender when catch_all_action is bounce.",
+			},
+			"catch_all_forward_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Address that receives mail for nonexistent addresses when catch_all_action is forward.",
+			},
+			"dkim_signing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether outgoing mail is signed with DKIM. Omit to leave the current setting untouched. This is the same setting as dkim_enabled of plesk_mail_authentication, so do not set both.",
+			},
+			"greylisting": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether greylisting spam protection is enabled for the domain.",
+			},
+			"outgoing_messages_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntAtLeast(-1),
+				Description:  "Maximum number of outgoing messages per hour for the domain. -1 uses the subscription default.",
+			},
+			"mailing_lists": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the mailing list service is enabled for the domain.",
+			},
+		},
+	}
+}
+
+// mailSettingsBody mirrors the body of the /api/v2/domains/{id}/mail-settings endpoint.
+type mailSettingsBody struct {
+	MailService            bool   `json:"mail_service"`
+	Webmail                string `json:"webmail"`
+	CatchAllAction         string `json:"catch_all_action"`
+	CatchAllBounceMessage  string `json:"catch_all_bounce_message,omitempty"`
+	CatchAllForwardAddress string `json:"catch_all_forward_address,omitempty"`
+	DKIMSigning            *bool  `json:"dkim_signing,omitempty"`
+	Greylisting            bool   `json:"greylisting"`
+	OutgoingMessagesLimit  int    `json:"outgoing_messages_limit"`
+	MailingLists           bool   `json:"mailing_lists"`
+}
+
+func resourceMailSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("domain_id").(string))
+
+	if diags := resourceMailSettingsPut(ctx, d, m); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceMailSettingsRead(ctx, d, m)
+}
+
+func resourceMailSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, diags := client.Get(ctx, fmt.Sprintf("/api/v2/domains/%s/mail-settings", d.Id()))
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp mailSettingsBody
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse mail settings response: %s", err)
+	}
+
+	d.Set("domain_id", d.Id())
+	d.Set("mail_service", resp.MailService)
+	d.Set("webmail", resp.Webmail)
+	d.Set("catch_all_action", resp.CatchAllAction)
+	d.Set("catch_all_bounce_message", resp.CatchAllBounceMessage)
+	d.Set("catch_all_forward_address", resp.CatchAllForwardAddress)
+	if resp.DKIMSigning != nil {
+		d.Set("dkim_signing", *resp.DKIMSigning)
+	}
+	d.Set("greylisting", resp.Greylisting)
+	d.Set("outgoing_messages_limit", resp.OutgoingMessagesLimit)
+	d.Set("mailing_lists", resp.MailingLists)
+
+	return nil
+}
+
+func resourceMailSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := resourceMailSettingsPut(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return resourceMailSettingsRead(ctx, d, m)
+}
+
+func resourceMailSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Mail settings cannot be removed from a domain, only changed. Dropping
+	// the resource leaves the current configuration in place.
+	d.SetId("")
+	return nil
+}
+
+func resourceMailSettingsPut(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	reqBody := mailSettingsBody{
+		MailService:           d.Get("mail_service").(bool),
+		Webmail:               d.Get("webmail").(string),
+		CatchAllAction:        d.Get("catch_all_action").(string),
+		Greylisting:           d.Get("greylisting").(bool),
+		OutgoingMessagesLimit: d.Get("outgoing_messages_limit").(int),
+		MailingLists:          d.Get("mailing_lists").(bool),
+	}
+
+	switch reqBody.CatchAllAction {
+	case "bounce":
+		reqBody.CatchAllBounceMessage = d.Get("catch_all_bounce_message").(string)
+	case "forward":
+		reqBody.CatchAllForwardAddress = d.Get("catch_all_forward_address").(string)
+	}
+
+	// Only send dkim_signing when it is configured, so that the setting can
+	// be left to plesk_mail_authentication.
+	if raw := d.GetRawConfig(); !raw.IsNull() && !raw.GetAttr("dkim_signing").IsNull() {
+		dkimSigning := d.Get("dkim_signing").(bool)
+		reqBody.DKIMSigning = &dkimSigning
+	}
+
+	_, diags := client.Put(ctx, fmt.Sprintf("/api/v2/domains/%s/mail-settings", d.Id()), reqBody)
+	return diags
+}
+
+// resourceMailSettingsCustomizeDiff checks that the catch-all bounce message
+// and forward address are only set for the action that uses them; Plesk
+// does not store them otherwise, which would leave a permanent diff.
+func resourceMailSettingsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("catch_all_action") {
+		return nil
+	}
+	action := d.Get("catch_all_action").(string)
+
+	if d.NewValueKnown("catch_all_bounce_message") && action != "bounce" && d.Get("catch_all_bounce_message").(string) != "" {
+		return fmt.Errorf("catch_all_bounce_message can only be set when catch_all_action is bounce, not %s", action)
+	}
+
+	if d.NewValueKnown("catch_all_forward_address") {
+		forwardAddress := d.Get("catch_all_forward_address").(string)
+		if action == "forward" && forwardAddress == "" {
+			return fmt.Errorf("catch_all_forward_address is required when catch_all_action is forward")
+		}
+		if action != "forward" && forwardAddress != "" {
+			return fmt.Errorf("catch_all_forward_address can only be set when catch_all_action is forward, not %s", action)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package plesk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceMailSettingsCustomizeDiff(t *testing.T) {
+	cases := []struct {
+		name    string
+		config  map[string]interface{}
+		wantErr bool
+	}{
+		{"reject", map[string]interface{}{"catch_all_action": "reject"}, false},
+		{"bounce with message", map[string]interface{}{"catch_all_action": "bounce", "catch_all_bounce_message": "No such user"}, false},
+		{"bounce without message", map[string]interface{}{"catch_all_action": "bounce"}, false},
+		{"forward with address", map[string]interface{}{"catch_all_action": "forward", "catch_all_forward_address": "admin@example.com"}, false},
+		{"forward without address", map[string]interface{}{"catch_all_action": "forward"}, true},
+		{"message without bounce", map[string]interface{}{"catch_all_action": "reject", "catch_all_bounce_message": "No such user"}, true},
+		{"address without forward", map[string]interface{}{"catch_all_action": "bounce", "catch_all_forward_address": "admin@example.com"}, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.config["domain_id"] = "1"
+
+			_, err := ResourceMailSettings().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), nil)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Diff() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
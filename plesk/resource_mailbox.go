@@ -4,15 +4,18 @@ import (
     "context"
     "encoding/json"
     "fmt"
+    "net/url"
 
     "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
     "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+    "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceMailbox() *schema.Resource {
     return &schema.Resource{
         CreateContext: resourceMailboxCreate,
         ReadContext:   resourceMailboxRead,
+        UpdateContext: resourceMailboxUpdate,
         DeleteContext: resourceMailboxDelete,
         Schema: map[string]*schema.Schema{
             "email": {
@@ -25,16 +28,77 @@ func ResourceMailbox() *schema.Resource {
                 Required:  true,
                 Sensitive: true,
             },
+            "enabled": {
+                Type:        schema.TypeBool,
+                Optional:    true,
+                Computed:    true,
+                Description: "Whether the mailbox can receive mail.",
+            },
+            "quota": {
+                Type:         schema.TypeInt,
+                Optional:     true,
+                Computed:     true,
+                ValidateFunc: validation.IntAtLeast(-1),
+                Description:  "Mailbox size limit in megabytes. -1 uses the subscription default. Omit to leave the current limit untouched.",
+            },
+            "quota_usage": {
+                Type:        schema.TypeInt,
+                Computed:    true,
+                Description: "Disk space used by the mailbox in bytes.",
+            },
+            "description": {
+                Type:        schema.TypeString,
+                Optional:    true,
+                Computed:    true,
+                Description: "Description of the mailbox shown in the panel.",
+            },
+            "control_panel_access": {
+                Type:        schema.TypeBool,
+                Optional:    true,
+                Computed:    true,
+                Description: "Whether the mailbox owner can log in to the control panel.",
+            },
+            "forwarding_addresses": {
+                Type:        schema.TypeSet,
+                Optional:    true,
+                Computed:    true,
+                Elem:        &schema.Schema{Type: schema.TypeString},
+                Description: "Addresses incoming mail is forwarded to.",
+            },
+            "forwarding_keep_copy": {
+                Type:        schema.TypeBool,
+                Optional:    true,
+                Computed:    true,
+                Description: "Whether to keep a copy of forwarded mail in the mailbox.",
+            },
+            "aliases": {
+                Type:        schema.TypeSet,
+                Optional:    true,
+                Computed:    true,
+                Elem:        &schema.Schema{Type: schema.TypeString},
+                Description: "Alternative local parts that deliver to this mailbox. Omit to leave the current aliases untouched, e.g. when they are managed with plesk_mail_alias.",
+            },
+            "antivirus": {
+                Type:        schema.TypeBool,
+                Optional:    true,
+                Computed:    true,
+                Description: "Whether incoming and outgoing mail is scanned for viruses.",
+            },
+            "antispam": {
+                Type:        schema.TypeBool,
+                Optional:    true,
+                Computed:    true,
+                Description: "Whether incoming mail is checked for spam.",
+            },
         },
     }
 }
 
 func resourceMailboxCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
     client := m.(*Client)
-    payload := map[string]interface{}{
-        "email":    d.Get("email"),
-        "password": d.Get("password"),
-    }
+    payload := mailboxPayload(d)
+    payload["email"] = d.Get("email")
+    payload["password"] = d.Get("password")
 
     respBody, diags := client.Post(ctx, "/api/v2/mail", payload)
     if diags.HasError() {
@@ -71,8 +135,18 @@ func resourceMailboxRead(ctx context.Context, d *schema.ResourceData, m interfac
 
     var response struct {
         Mailboxes []struct {
-            ID    string `json:"id"`
-            Email string `json:"email"`
+            ID                  string   `json:"id"`
+            Email               string   `json:"email"`
+            Enabled             bool     `json:"enabled"`
+            Quota               int      `json:"quota"`
+            QuotaUsage          int      `json:"quota_usage"`
+            Description         string   `json:"description,omitempty"`
+            ControlPanelAccess  bool     `json:"control_panel_access"`
+            ForwardingAddresses []string `json:"forwarding_addresses,omitempty"`
+            ForwardingKeepCopy  bool     `json:"forwarding_keep_copy"`
+            Aliases             []string `json:"aliases,omitempty"`
+            Antivirus           bool     `json:"antivirus"`
+            Antispam            bool     `json:"antispam"`
         } `json:"mailboxes"`
     }
 
@@ -87,6 +161,17 @@ func resourceMailboxRead(ctx context.Context, d *schema.ResourceData, m interfac
     for _, mailbox := range response.Mailboxes {
         if mailbox.ID == d.Id() || mailbox.Email == d.Id() {
             d.Set("email", mailbox.Email)
+            d.Set("enabled", mailbox.Enabled)
+            d.Set("quota", mailbox.Quota)
+            d.Set("quota_usage", mailbox.QuotaUsage)
+            d.Set("description", mailbox.Description)
+            d.Set("control_panel_access", mailbox.ControlPanelAccess)
+            d.Set("forwarding_addresses", mailbox.ForwardingAddresses)
+            d.Set("forwarding_keep_copy", mailbox.ForwardingKeepCopy)
+            d.Set("aliases", mailbox.Aliases)
+            d.Set("antivirus", mailbox.Antivirus)
+            d.Set("antispam", mailbox.Antispam)
+            // Note: password is sensitive, generally not retrievable, so do not set
             return nil
         }
     }
@@ -95,9 +180,26 @@ func resourceMailboxRead(ctx context.Context, d *schema.ResourceData, m interfac
     return nil
 }
 
+func resourceMailboxUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+    client := m.(*Client)
+
+    payload := mailboxPayload(d)
+    if d.HasChange("password") {
+        payload["password"] = d.Get("password")
+    }
+
+    path := fmt.Sprintf("/api/v2/mail/%s", url.PathEscape(d.Id()))
+    _, diags := client.Put(ctx, path, payload)
+    if diags.HasError() {
+        return diags
+    }
+
+    return resourceMailboxRead(ctx, d, m)
+}
+
 func resourceMailboxDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
     client := m.(*Client)
-    path := fmt.Sprintf("/api/v2/mail/%s", d.Id())
+    path := fmt.Sprintf("/api/v2/mail/%s", url.PathEscape(d.Id()))
     _, diags := client.Post(ctx, path, nil) // Use DELETE if supported
     if diags.HasError() {
         return diags
@@ -105,3 +207,42 @@ func resourceMailboxDelete(ctx context.Context, d *schema.ResourceData, m interf
     d.SetId("")
     return nil
 }
+
+// mailboxSettings lists the optional mailbox attributes sent with create and
+// update requests.
+var mailboxSettings = []string{
+    "enabled",
+    "quota",
+    "description",
+    "control_panel_access",
+    "forwarding_addresses",
+    "forwarding_keep_copy",
+    "aliases",
+    "antivirus",
+    "antispam",
+}
+
+// mailboxPayload builds the mailbox settings shared by create and update
+// requests. Only configured attributes are sent, so settings left out of
+// the configuration keep the value they have in Plesk.
+func mailboxPayload(d *schema.ResourceData) map[string]interface{} {
+    payload := map[string]interface{}{}
+
+    raw := d.GetRawConfig()
+    if raw.IsNull() {
+        return payload
+    }
+
+    for _, key := range mailboxSettings {
+        if raw.GetAttr(key).IsNull() {
+            continue
+        }
+        if set, ok := d.Get(key).(*schema.Set); ok {
+            payload[key] = set.List()
+        } else {
+            payload[key] = d.Get(key)
+        }
+    }
+
+    return payload
+}
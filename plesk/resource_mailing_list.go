@@ -0,0 +1,180 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceMailingList defines the Mailman mailing list resource schema and CRUD operations.
+func ResourceMailingList() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMailingListCreate,
+		ReadContext:   resourceMailingListRead,
+		UpdateContext: resourceMailingListUpdate,
+		DeleteContext: resourceMailingListDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the domain the mailing list belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the mailing list (the local part of its address).",
+			},
+			"admin_email": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Email address of the list administrator.",
+			},
+			"admin_password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password of the list administrator.",
+			},
+			"subscribers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Email addresses subscribed to the list.",
+			},
+		},
+	}
+}
+
+func resourceMailingListCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	reqBody := map[string]interface{}{
+		"domain_id":      d.Get("domain_id").(string),
+		"name":           d.Get("name").(string),
+		"admin_email":    d.Get("admin_email").(string),
+		"admin_password": d.Get("admin_password").(string),
+	}
+
+	respBody, diags := client.Post(ctx, "/api/v2/mailing-lists", reqBody)
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse mailing list create response: %s", err)
+	}
+
+	d.SetId(resp.ID)
+
+	subscribers := expandStringSet(d.Get("subscribers").(*schema.Set))
+	if diags := resourceMailingListSubscribe(ctx, client, d.Id(), subscribers); diags.HasError() {
+		return diags
+	}
+
+	return resourceMailingListRead(ctx, d, m)
+}
+
+func resourceMailingListRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, diags := client.Get(ctx, fmt.Sprintf("/api/v2/mailing-lists/%s", d.Id()))
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp struct {
+		ID          string   `json:"id"`
+		DomainID    string   `json:"domain_id"`
+		Name        string   `json:"name"`
+		AdminEmail  string   `json:"admin_email"`
+		Subscribers []string `json:"subscribers"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse mailing list read response: %s", err)
+	}
+
+	d.Set("domain_id", resp.DomainID)
+	d.Set("name", resp.Name)
+	d.Set("admin_email", resp.AdminEmail)
+	d.Set("subscribers", resp.Subscribers)
+	// Note: admin_password is sensitive, generally not retrievable, so do not set
+
+	return nil
+}
+
+func resourceMailingListUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if d.HasChanges("admin_email", "admin_password") {
+		reqBody := map[string]interface{}{
+			"admin_email": d.Get("admin_email").(string),
+		}
+		if d.HasChange("admin_password") {
+			reqBody["admin_password"] = d.Get("admin_password").(string)
+		}
+
+		if _, diags := client.Put(ctx, fmt.Sprintf("/api/v2/mailing-lists/%s", d.Id()), reqBody); diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChange("subscribers") {
+		o, n := d.GetChange("subscribers")
+		oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
+
+		removed := expandStringSet(oldSet.Difference(newSet))
+		if diags := resourceMailingListUnsubscribe(ctx, client, d.Id(), removed); diags.HasError() {
+			return diags
+		}
+
+		added := expandStringSet(newSet.Difference(oldSet))
+		if diags := resourceMailingListSubscribe(ctx, client, d.Id(), added); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceMailingListRead(ctx, d, m)
+}
+
+func resourceMailingListDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	return client.Delete(ctx, fmt.Sprintf("/api/v2/mailing-lists/%s", d.Id()))
+}
+
+func resourceMailingListSubscribe(ctx context.Context, client *Client, listID string, emails []string) diag.Diagnostics {
+	if len(emails) == 0 {
+		return nil
+	}
+
+	reqBody := map[string]interface{}{
+		"emails": emails,
+	}
+
+	_, diags := client.Post(ctx, fmt.Sprintf("/api/v2/mailing-lists/%s/subscribers", listID), reqBody)
+	return diags
+}
+
+func resourceMailingListUnsubscribe(ctx context.Context, client *Client, listID string, emails []string) diag.Diagnostics {
+	for _, email := range emails {
+		path := fmt.Sprintf("/api/v2/mailing-lists/%s/subscribers/%s", listID, url.PathEscape(email))
+		if diags := client.Delete(ctx, path); diags.HasError() {
+			return diags
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourcePHPSettings defines the per-domain PHP settings resource schema and CRUD operations.
+func ResourcePHPSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePHPSettingsCreate,
+		ReadContext:   resourcePHPSettingsRead,
+		UpdateContext: resourcePHPSettingsUpdate,
+		DeleteContext: resourcePHPSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the domain the PHP settings apply to.",
+			},
+			"handler_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the PHP handler serving the domain (e.g., plesk-php82-fpm). See the plesk_php_handlers data source.",
+			},
+			"php_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "PHP version provided by the selected handler.",
+			},
+			"memory_limit": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Value of the memory_limit php.ini directive (e.g., 256M).",
+			},
+			"max_execution_time": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Value of the max_execution_time php.ini directive in seconds.",
+			},
+			"upload_max_filesize": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Value of the upload_max_filesize php.ini directive (e.g., 64M).",
+			},
+			"directives": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional php.ini directives to set for the domain.",
+			},
+			"fpm": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "PHP-FPM pool settings. Only used with FPM handlers. Settings that are omitted keep the value Plesk reports.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pm": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "ondemand",
+							ValidateFunc: validation.StringInSlice([]string{"static", "dynamic", "ondemand"}, false),
+							Description:  "Process manager mode (static, dynamic or ondemand).",
+						},
+						"max_children": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of child processes (pm.max_children).",
+						},
+						"start_servers": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Number of child processes created on startup (pm.start_servers).",
+						},
+						"min_spare_servers": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Minimum number of idle child processes (pm.min_spare_servers).",
+						},
+						"max_spare_servers": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of idle child processes (pm.max_spare_servers).",
+						},
+						"max_requests": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Number of requests each child process serves before respawning (pm.max_requests). 0 means no limit.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// phpSettingsResponse mirrors the body of GET /api/v2/domains/{id}/php-settings.
+type phpSettingsResponse struct {
+	HandlerID string            `json:"handler_id"`
+	Version   string            `json:"version"`
+	Settings  map[string]string `json:"settings"`
+	FPM       *struct {
+		PM              string `json:"pm"`
+		MaxChildren     int    `json:"max_children"`
+		StartServers    int    `json:"start_servers"`
+		MinSpareServers int    `json:"min_spare_servers"`
+		MaxSpareServers int    `json:"max_spare_servers"`
+		MaxRequests     int    `json:"max_requests"`
+	} `json:"fpm,omitempty"`
+}
+
+func resourcePHPSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("domain_id").(string))
+
+	if diags := resourcePHPSettingsPut(ctx, d, m); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourcePHPSettingsRead(ctx, d, m)
+}
+
+func resourcePHPSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	path := fmt.Sprintf("/api/v2/domains/%s/php-settings", d.Id())
+	respBody, diags := client.Get(ctx, path)
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp phpSettingsResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse PHP settings response: %s", err)
+	}
+
+	d.Set("domain_id", d.Id())
+	d.Set("handler_id", resp.HandlerID)
+	d.Set("php_version", resp.Version)
+	d.Set("memory_limit", resp.Settings["memory_limit"])
+	d.Set("max_execution_time", resp.Settings["max_execution_time"])
+	d.Set("upload_max_filesize", resp.Settings["upload_max_filesize"])
+
+	// Plesk reports every effective directive, so only track the ones that
+	// are managed through configuration to avoid a perpetual diff.
+	directives := map[string]interface{}{}
+	for name := range d.Get("directives").(map[string]interface{}) {
+		if v, ok := resp.Settings[name]; ok {
+			directives[name] = v
+		}
+	}
+	d.Set("directives", directives)
+
+	if _, ok := d.GetOk("fpm"); ok && resp.FPM != nil {
+		d.Set("fpm", []interface{}{map[string]interface{}{
+			"pm":                resp.FPM.PM,
+			"max_children":      resp.FPM.MaxChildren,
+			"start_servers":     resp.FPM.StartServers,
+			"min_spare_servers": resp.FPM.MinSpareServers,
+			"max_spare_servers": resp.FPM.MaxSpareServers,
+			"max_requests":      resp.FPM.MaxRequests,
+		}})
+	}
+
+	return nil
+}
+
+func resourcePHPSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := resourcePHPSettingsPut(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return resourcePHPSettingsRead(ctx, d, m)
+}
+
+func resourcePHPSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// PHP settings cannot be removed from a domain, only changed. Dropping
+	// the resource leaves the current configuration in place.
+	d.SetId("")
+	return nil
+}
+
+func resourcePHPSettingsPut(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	settings := map[string]interface{}{}
+	// Directives removed from the configuration are sent as null, so that
+	// Plesk resets them instead of silently keeping the old values.
+	oldDirectives, newDirectives := d.GetChange("directives")
+	for name := range oldDirectives.(map[string]interface{}) {
+		settings[name] = nil
+	}
+	for name, v := range newDirectives.(map[string]interface{}) {
+		settings[name] = v
+	}
+	for _, name := range []string{"memory_limit", "max_execution_time", "upload_max_filesize"} {
+		if v, ok := d.GetOk(name); ok {
+			settings[name] = v.(string)
+		}
+	}
+
+	reqBody := map[string]interface{}{
+		"settings": settings,
+	}
+
+	if v, ok := d.GetOk("handler_id"); ok {
+		reqBody["handler_id"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("fpm"); ok {
+		fpm := v.([]interface{})[0].(map[string]interface{})
+		fpmBody := map[string]interface{}{
+			"pm": fpm["pm"].(string),
+		}
+		// Only send configured values, as is, so that max_requests = 0 (no
+		// limit) is not mistaken for an unset value. The others keep the
+		// value Plesk has.
+		rawFPM := rawPHPSettingsFPMConfig(d)
+		for _, name := range []string{"max_children", "start_servers", "min_spare_servers", "max_spare_servers", "max_requests"} {
+			if !rawFPM.IsNull() && !rawFPM.GetAttr(name).IsNull() {
+				fpmBody[name] = fpm[name].(int)
+			}
+		}
+		reqBody["fpm"] = fpmBody
+	}
+
+	path := fmt.Sprintf("/api/v2/domains/%s/php-settings", d.Id())
+	_, diags := client.Put(ctx, path, reqBody)
+	return diags
+}
+
+// rawPHPSettingsFPMConfig returns the configured fpm block, or a null value
+// when there is none.
+func rawPHPSettingsFPMConfig(d *schema.ResourceData) cty.Value {
+	raw := d.GetRawConfig()
+	if raw.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	fpm := raw.GetAttr("fpm")
+	if fpm.IsNull() || !fpm.IsKnown() || fpm.LengthInt() == 0 {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	return fpm.Index(cty.NumberIntVal(0))
+}
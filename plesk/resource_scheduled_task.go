@@ -0,0 +1,325 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cronFieldRanges holds the allowed values of the five cron schedule fields.
+// names lists the case-insensitive names accepted for the values from min
+// on, such as jan or mon.
+var cronFieldRanges = []struct {
+	name     string
+	min, max int
+	names    []string
+}{
+	{"minute", 0, 59, nil},
+	{"hour", 0, 23, nil},
+	{"day of month", 1, 31, nil},
+	{"month", 1, 12, []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	{"day of week", 0, 7, []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
+
+var cronMacros = []string{"@reboot", "@hourly", "@daily", "@midnight", "@weekly", "@monthly", "@yearly", "@annually"}
+
+var cronTermPattern = regexp.MustCompile(`^(\*|[0-9A-Za-z]+(-[0-9A-Za-z]+)?)(/\d+)?$`)
+
+// ResourceScheduledTask defines a cron job of a subscription, or of the
+// server when no domain is given.
+func ResourceScheduledTask() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScheduledTaskCreate,
+		ReadContext:   resourceScheduledTaskRead,
+		UpdateContext: resourceScheduledTaskUpdate,
+		DeleteContext: resourceScheduledTaskDelete,
+		CustomizeDiff: resourceScheduledTaskCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the subscription's domain the task belongs to. Omit for a server-wide task.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"command", "php", "url"}, false),
+				Description:  "Task type: run a command, run a PHP script, or fetch a URL.",
+			},
+			"command": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Command to run. Required for command tasks.",
+			},
+			"php_script": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of the PHP script to run. Required for php tasks.",
+			},
+			"php_arguments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arguments passed to the PHP script.",
+			},
+			"php_handler_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the PHP handler that runs the script. See the plesk_php_handlers data source.",
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "URL to fetch. Required for url tasks.",
+			},
+			"schedule": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCronSchedule,
+				Description:  "Cron schedule of the task (e.g., \"*/15 * * * *\", \"0 6 * * mon-fri\" or @daily).",
+			},
+			"system_user": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "System user the task runs as. Defaults to the subscription's system user, or root for server tasks.",
+			},
+			"notify": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "errors",
+				ValidateFunc: validation.StringInSlice([]string{"none", "errors", "always"}, false),
+				Description:  "When to send the task output by email (none, errors or always).",
+			},
+			"notification_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Address notifications are sent to. Defaults to the subscription owner's address.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the task shown in the panel.",
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the task is run on its schedule.",
+			},
+		},
+	}
+}
+
+// scheduledTaskBody mirrors the body of the /api/v2/scheduled-tasks endpoints.
+type scheduledTaskBody struct {
+	ID                string `json:"id,omitempty"`
+	DomainID          string `json:"domain_id,omitempty"`
+	Type              string `json:"type"`
+	Command           string `json:"command,omitempty"`
+	PHPScript         string `json:"php_script,omitempty"`
+	PHPArguments      string `json:"php_arguments,omitempty"`
+	PHPHandlerID      string `json:"php_handler_id,omitempty"`
+	URL               string `json:"url,omitempty"`
+	Schedule          string `json:"schedule"`
+	SystemUser        string `json:"system_user,omitempty"`
+	Notify            string `json:"notify"`
+	NotificationEmail string `json:"notification_email,omitempty"`
+	Description       string `json:"description"`
+	Active            bool   `json:"active"`
+}
+
+func resourceScheduledTaskCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, diags := client.Post(ctx, "/api/v2/scheduled-tasks", expandScheduledTask(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse scheduled task create response: %s", err)
+	}
+
+	d.SetId(resp.ID)
+	return resourceScheduledTaskRead(ctx, d, m)
+}
+
+func resourceScheduledTaskRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, found, diags := client.GetIfExists(ctx, fmt.Sprintf("/api/v2/scheduled-tasks/%s", d.Id()))
+	if diags.HasError() {
+		return diags
+	}
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	var task scheduledTaskBody
+	if err := json.Unmarshal(respBody, &task); err != nil {
+		return diag.Errorf("failed to parse scheduled task read response: %s", err)
+	}
+
+	d.Set("domain_id", task.DomainID)
+	d.Set("type", task.Type)
+	d.Set("command", task.Command)
+	d.Set("php_script", task.PHPScript)
+	d.Set("php_arguments", task.PHPArguments)
+	d.Set("php_handler_id", task.PHPHandlerID)
+	d.Set("url", task.URL)
+	d.Set("schedule", task.Schedule)
+	d.Set("system_user", task.SystemUser)
+	d.Set("notify", task.Notify)
+	d.Set("notification_email", task.NotificationEmail)
+	d.Set("description", task.Description)
+	d.Set("active", task.Active)
+
+	return nil
+}
+
+func resourceScheduledTaskUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	path := fmt.Sprintf("/api/v2/scheduled-tasks/%s", d.Id())
+	if _, diags := client.Put(ctx, path, expandScheduledTask(d)); diags.HasError() {
+		return diags
+	}
+
+	return resourceScheduledTaskRead(ctx, d, m)
+}
+
+func resourceScheduledTaskDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	return client.Delete(ctx, fmt.Sprintf("/api/v2/scheduled-tasks/%s", d.Id()))
+}
+
+// resourceScheduledTaskCustomizeDiff checks that the attribute required by
+// the task type is set and that no other task type's attribute is.
+func resourceScheduledTaskCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	taskType := d.Get("type").(string)
+
+	required := map[string]string{
+		"command": "command",
+		"php":     "php_script",
+		"url":     "url",
+	}
+
+	for t, attr := range required {
+		if !d.NewValueKnown(attr) {
+			continue
+		}
+		_, set := d.GetOk(attr)
+		switch {
+		case t == taskType && !set:
+			return fmt.Errorf("%s is required for %s tasks", attr, taskType)
+		case t != taskType && set:
+			return fmt.Errorf("%s cannot be used with %s tasks", attr, taskType)
+		}
+	}
+
+	if _, ok := d.GetOk("php_arguments"); ok && taskType != "php" {
+		return fmt.Errorf("php_arguments can only be used with php tasks")
+	}
+
+	return nil
+}
+
+func expandScheduledTask(d *schema.ResourceData) scheduledTaskBody {
+	return scheduledTaskBody{
+		DomainID:          d.Get("domain_id").(string),
+		Type:              d.Get("type").(string),
+		Command:           d.Get("command").(string),
+		PHPScript:         d.Get("php_script").(string),
+		PHPArguments:      d.Get("php_arguments").(string),
+		PHPHandlerID:      d.Get("php_handler_id").(string),
+		URL:               d.Get("url").(string),
+		Schedule:          d.Get("schedule").(string),
+		SystemUser:        d.Get("system_user").(string),
+		Notify:            d.Get("notify").(string),
+		NotificationEmail: d.Get("notification_email").(string),
+		Description:       d.Get("description").(string),
+		Active:            d.Get("active").(bool),
+	}
+}
+
+// validateCronSchedule accepts a five-field cron expression made of *,
+// numbers, month and day names, ranges, steps and lists, or one of the
+// @hourly style macros, including @reboot.
+func validateCronSchedule(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	for _, macro := range cronMacros {
+		if v == macro {
+			return nil, nil
+		}
+	}
+
+	fields := strings.Fields(v)
+	if len(fields) != len(cronFieldRanges) {
+		return nil, []error{fmt.Errorf("%s: expected %d fields (minute hour day-of-month month day-of-week) or a macro such as @daily, got %q", k, len(cronFieldRanges), v)}
+	}
+
+	var errs []error
+	for i, field := range fields {
+		r := cronFieldRanges[i]
+		for _, term := range strings.Split(field, ",") {
+			match := cronTermPattern.FindStringSubmatch(term)
+			if match == nil {
+				errs = append(errs, fmt.Errorf("%s: invalid %s value %q", k, r.name, term))
+				continue
+			}
+			if match[1] == "*" {
+				continue
+			}
+			for _, n := range strings.Split(match[1], "-") {
+				value, err := strconv.Atoi(n)
+				if err != nil {
+					if value = cronNameValue(r.names, r.min, n); value < 0 {
+						errs = append(errs, fmt.Errorf("%s: invalid %s value %q", k, r.name, n))
+					}
+					continue
+				}
+				if value < r.min || value > r.max {
+					errs = append(errs, fmt.Errorf("%s: %s value %d is out of range %d-%d", k, r.name, value, r.min, r.max))
+				}
+			}
+		}
+	}
+
+	return nil, errs
+}
+
+// cronNameValue returns the value of a month or day name, counting from
+// min, or -1 when name is not one of names.
+func cronNameValue(names []string, min int, name string) int {
+	for i, n := range names {
+		if strings.EqualFold(n, name) {
+			return min + i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,44 @@
+package plesk
+
+import "testing"
+
+func TestValidateCronSchedule(t *testing.T) {
+	cases := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"*/15 * * * *", false},
+		{"0 0 1 1 *", false},
+		{"0 6 * * 1-5", false},
+		{"0,30 8-18/2 * * 0,7", false},
+		{"0 6 * * mon-fri", false},
+		{"0 6 * * MON,WED,Fri", false},
+		{"0 0 1 jan,jul *", false},
+		{"0 0 1 Dec *", false},
+		{"0 0 * jan-mar/2 sun", false},
+		{"@daily", false},
+		{"@midnight", false},
+		{"@reboot", false},
+		{"@annually", false},
+		{"60 * * * *", true},
+		{"* 24 * * *", true},
+		{"* * 0 * *", true},
+		{"* * * 13 *", true},
+		{"* * * * 8", true},
+		{"0 6 * * monday", true},
+		{"0 0 1 jan-foo *", true},
+		{"0 0 mon * *", true},
+		{"0 6 * mon *", true},
+		{"* * * *", true},
+		{"@every 5m", true},
+		{"@DAILY", true},
+		{"", true},
+	}
+
+	for _, tc := range cases {
+		_, errs := validateCronSchedule(tc.value, "schedule")
+		if (len(errs) > 0) != tc.wantErr {
+			t.Errorf("validateCronSchedule(%q) errors = %v, wantErr %v", tc.value, errs, tc.wantErr)
+		}
+	}
+}
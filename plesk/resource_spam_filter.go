@@ -0,0 +1,164 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceSpamFilter defines the SpamAssassin settings of a mailbox, or the
+// server-wide settings when no mailbox is given. The resource ID is the
+// mailbox address or "server".
+func ResourceSpamFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSpamFilterCreate,
+		ReadContext:   resourceSpamFilterRead,
+		UpdateContext: resourceSpamFilterUpdate,
+		DeleteContext: resourceSpamFilterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"mailbox": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Full address of the mailbox to configure. Omit to configure the server-wide spam filter.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether SpamAssassin filtering is enabled.",
+			},
+			"score_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      7.0,
+				ValidateFunc: validation.FloatBetween(0, 100),
+				Description:  "Score at which a message is considered spam.",
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "mark",
+				ValidateFunc: validation.StringInSlice([]string{"mark", "move", "delete"}, false),
+				Description:  "What to do with spam: mark it, move it to the Spam folder, or delete it.",
+			},
+			"subject_tag": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "*****SPAM*****",
+				Description: "Text prepended to the subject of messages marked as spam.",
+			},
+			"allow_list": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Sender address patterns that are never treated as spam (e.g., *@example.com).",
+			},
+			"block_list": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Sender address patterns that are always treated as spam.",
+			},
+		},
+	}
+}
+
+// spamFilterBody mirrors the body of the spam filter endpoints.
+type spamFilterBody struct {
+	Enabled        bool     `json:"enabled"`
+	ScoreThreshold float64  `json:"score_threshold"`
+	Action         string   `json:"action"`
+	SubjectTag     string   `json:"subject_tag"`
+	AllowList      []string `json:"allow_list"`
+	BlockList      []string `json:"block_list"`
+}
+
+func resourceSpamFilterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if v, ok := d.GetOk("mailbox"); ok {
+		d.SetId(v.(string))
+	} else {
+		d.SetId("server")
+	}
+
+	if diags := resourceSpamFilterPut(ctx, d, m); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceSpamFilterRead(ctx, d, m)
+}
+
+func resourceSpamFilterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, diags := client.Get(ctx, spamFilterPath(d.Id()))
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp spamFilterBody
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse spam filter response: %s", err)
+	}
+
+	if d.Id() != "server" {
+		d.Set("mailbox", d.Id())
+	}
+	d.Set("enabled", resp.Enabled)
+	d.Set("score_threshold", resp.ScoreThreshold)
+	d.Set("action", resp.Action)
+	d.Set("subject_tag", resp.SubjectTag)
+	d.Set("allow_list", resp.AllowList)
+	d.Set("block_list", resp.BlockList)
+
+	return nil
+}
+
+func resourceSpamFilterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := resourceSpamFilterPut(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return resourceSpamFilterRead(ctx, d, m)
+}
+
+func resourceSpamFilterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Spam filter settings cannot be removed, only changed. Dropping the
+	// resource leaves the current configuration in place.
+	d.SetId("")
+	return nil
+}
+
+func resourceSpamFilterPut(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	reqBody := spamFilterBody{
+		Enabled:        d.Get("enabled").(bool),
+		ScoreThreshold: d.Get("score_threshold").(float64),
+		Action:         d.Get("action").(string),
+		SubjectTag:     d.Get("subject_tag").(string),
+		AllowList:      expandStringSet(d.Get("allow_list").(*schema.Set)),
+		BlockList:      expandStringSet(d.Get("block_list").(*schema.Set)),
+	}
+
+	_, diags := client.Put(ctx, spamFilterPath(d.Id()), reqBody)
+	return diags
+}
+
+func spamFilterPath(id string) string {
+	if id == "server" {
+		return "/api/v2/server/spam-filter"
+	}
+
+	return fmt.Sprintf("/api/v2/mail/%s/spam-filter", url.PathEscape(id))
+}
@@ -0,0 +1,271 @@
+package plesk
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceSSLCertificate defines the SSL/TLS certificate resource schema and CRUD operations.
+// Certificates are uploaded into a domain's repository, or into the server
+// repository when no domain is given.
+func ResourceSSLCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSSLCertificateCreate,
+		ReadContext:   resourceSSLCertificateRead,
+		DeleteContext: resourceSSLCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the certificate in the repository.",
+			},
+			"domain_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the domain whose repository stores the certificate. Omit to use the server repository.",
+			},
+			"certificate": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentCertificatePEM,
+				Description:      "PEM-encoded certificate.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded private key of the certificate.",
+			},
+			"ca_certificate": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentCertificateBundlePEM,
+				Description:      "PEM-encoded CA chain.",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Subject of the certificate.",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Issuer of the certificate.",
+			},
+			"not_before": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Start of the certificate validity period (RFC 3339).",
+			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiry of the certificate (RFC 3339).",
+			},
+			"subject_alternative_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "DNS names and IP addresses covered by the certificate.",
+			},
+		},
+	}
+}
+
+func resourceSSLCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	if _, err := parseCertificatePEM(d.Get("certificate").(string)); err != nil {
+		return diag.Errorf("invalid certificate: %s", err)
+	}
+
+	reqBody := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"certificate": d.Get("certificate").(string),
+		"private_key": d.Get("private_key").(string),
+	}
+
+	if v, ok := d.GetOk("domain_id"); ok {
+		reqBody["domain_id"] = v.(string)
+	} else {
+		reqBody["repository"] = "server"
+	}
+	if v, ok := d.GetOk("ca_certificate"); ok {
+		reqBody["ca_certificate"] = v.(string)
+	}
+
+	respBody, diags := client.Post(ctx, "/api/v2/certificates", reqBody)
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse certificate create response: %s", err)
+	}
+
+	d.SetId(resp.ID)
+	return resourceSSLCertificateRead(ctx, d, m)
+}
+
+func resourceSSLCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	respBody, diags := client.Get(ctx, fmt.Sprintf("/api/v2/certificates/%s", d.Id()))
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp struct {
+		ID            string `json:"id"`
+		Name          string `json:"name"`
+		DomainID      string `json:"domain_id,omitempty"`
+		Certificate   string `json:"certificate"`
+		CACertificate string `json:"ca_certificate,omitempty"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse certificate read response: %s", err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("domain_id", resp.DomainID)
+	d.Set("certificate", resp.Certificate)
+	d.Set("ca_certificate", resp.CACertificate)
+	// Note: the private key is never returned by the API, so keep the configured value
+
+	return setCertificateDetails(d, resp.Certificate)
+}
+
+func resourceSSLCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	return client.Delete(ctx, fmt.Sprintf("/api/v2/certificates/%s", d.Id()))
+}
+
+// parseCertificatePEM decodes the first certificate of a PEM bundle.
+func parseCertificatePEM(data string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(data)))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("no PEM-encoded certificate found")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// suppressEquivalentCertificatePEM ignores differences in PEM encoding, such
+// as line endings, wrapping or surrounding whitespace, that Plesk introduces
+// when it stores a certificate. Only a different certificate triggers a
+// replacement.
+func suppressEquivalentCertificatePEM(k, old, new string, d *schema.ResourceData) bool {
+	oldCert, err := parseCertificatePEM(old)
+	if err != nil {
+		return false
+	}
+	newCert, err := parseCertificatePEM(new)
+	if err != nil {
+		return false
+	}
+
+	return oldCert.Equal(newCert)
+}
+
+// suppressEquivalentCertificateBundlePEM is the chain version of
+// suppressEquivalentCertificatePEM: it ignores encoding differences and the
+// order of the certificates, but not a certificate added to or removed from
+// the chain.
+func suppressEquivalentCertificateBundlePEM(k, old, new string, d *schema.ResourceData) bool {
+	oldCerts, err := parseCertificateBundlePEM(old)
+	if err != nil {
+		return false
+	}
+	newCerts, err := parseCertificateBundlePEM(new)
+	if err != nil || len(oldCerts) != len(newCerts) {
+		return false
+	}
+
+	remaining := map[string]int{}
+	for _, cert := range oldCerts {
+		remaining[string(cert.Raw)]++
+	}
+	for _, cert := range newCerts {
+		if remaining[string(cert.Raw)] == 0 {
+			return false
+		}
+		remaining[string(cert.Raw)]--
+	}
+
+	return true
+}
+
+// parseCertificateBundlePEM decodes every certificate of a PEM bundle.
+func parseCertificateBundlePEM(data string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+
+	rest := []byte(strings.TrimSpace(data))
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block %s in certificate bundle", block.Type)
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+
+		rest = []byte(strings.TrimSpace(string(rest)))
+	}
+
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no PEM-encoded certificate found")
+	}
+
+	return certs, nil
+}
+
+// setCertificateDetails sets the computed subject, issuer, validity and SAN
+// attributes from a PEM-encoded certificate.
+func setCertificateDetails(d *schema.ResourceData, certificate string) diag.Diagnostics {
+	cert, err := parseCertificatePEM(certificate)
+	if err != nil {
+		return diag.Errorf("failed to parse certificate returned by Plesk: %s", err)
+	}
+
+	sans := make([]string, 0, len(cert.DNSNames)+len(cert.IPAddresses))
+	sans = append(sans, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+
+	d.Set("subject", cert.Subject.String())
+	d.Set("issuer", cert.Issuer.String())
+	d.Set("not_before", cert.NotBefore.UTC().Format(time.RFC3339))
+	d.Set("not_after", cert.NotAfter.UTC().Format(time.RFC3339))
+	d.Set("subject_alternative_names", sans)
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceSSLCertificateBinding secures a domain, its webmail, or the mail
+// server with a certificate from the repository.
+//
+// The resource ID is "<target>/<domain_id>" for domain and webmail bindings
+// and "mail" for the mail server.
+func ResourceSSLCertificateBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSSLCertificateBindingCreate,
+		ReadContext:   resourceSSLCertificateBindingRead,
+		UpdateContext: resourceSSLCertificateBindingUpdate,
+		DeleteContext: resourceSSLCertificateBindingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the certificate to secure the target with.",
+			},
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"domain", "webmail", "mail"}, false),
+				Description:  "What to secure: domain, webmail or mail (the server-wide mail service).",
+			},
+			"domain_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the domain to secure. Required for the domain and webmail targets.",
+			},
+		},
+	}
+}
+
+func resourceSSLCertificateBindingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	target := d.Get("target").(string)
+	domainID := d.Get("domain_id").(string)
+
+	switch {
+	case target == "mail" && domainID != "":
+		return diag.Errorf("domain_id must not be set for the mail target")
+	case target != "mail" && domainID == "":
+		return diag.Errorf("domain_id is required for the %s target", target)
+	}
+
+	if target == "mail" {
+		d.SetId(target)
+	} else {
+		d.SetId(fmt.Sprintf("%s/%s", target, domainID))
+	}
+
+	if diags := resourceSSLCertificateBindingPut(ctx, d, m); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceSSLCertificateBindingRead(ctx, d, m)
+}
+
+func resourceSSLCertificateBindingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	target, domainID, err := parseSSLCertificateBindingID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	respBody, diags := client.Get(ctx, sslCertificateBindingPath(target, domainID))
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp struct {
+		CertificateID string `json:"certificate_id"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse certificate binding response: %s", err)
+	}
+
+	// The target is no longer secured with any certificate
+	if resp.CertificateID == "" {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("target", target)
+	d.Set("domain_id", domainID)
+	d.Set("certificate_id", resp.CertificateID)
+
+	return nil
+}
+
+func resourceSSLCertificateBindingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := resourceSSLCertificateBindingPut(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return resourceSSLCertificateBindingRead(ctx, d, m)
+}
+
+func resourceSSLCertificateBindingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	target, domainID, err := parseSSLCertificateBindingID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return client.Delete(ctx, sslCertificateBindingPath(target, domainID))
+}
+
+func resourceSSLCertificateBindingPut(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	target, domainID, err := parseSSLCertificateBindingID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	reqBody := map[string]interface{}{
+		"certificate_id": d.Get("certificate_id").(string),
+	}
+
+	_, diags := client.Put(ctx, sslCertificateBindingPath(target, domainID), reqBody)
+	return diags
+}
+
+// parseSSLCertificateBindingID splits a binding ID into its target and domain ID.
+func parseSSLCertificateBindingID(id string) (string, string, error) {
+	if id == "mail" {
+		return id, "", nil
+	}
+
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[1] == "" || (parts[0] != "domain" && parts[0] != "webmail") {
+		return "", "", fmt.Errorf("unexpected certificate binding ID %q, expected domain/<domain_id>, webmail/<domain_id> or mail", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func sslCertificateBindingPath(target, domainID string) string {
+	switch target {
+	case "mail":
+		return "/api/v2/server/mail/certificate"
+	case "webmail":
+		return fmt.Sprintf("/api/v2/domains/%s/webmail/certificate", domainID)
+	default:
+		return fmt.Sprintf("/api/v2/domains/%s/certificate", domainID)
+	}
+}
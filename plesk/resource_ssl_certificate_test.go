@@ -0,0 +1,98 @@
+package plesk
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSuppressEquivalentCertificatePEM(t *testing.T) {
+	cert := testCertificatePEM(t, "example.com")
+	other := testCertificatePEM(t, "example.org")
+
+	cases := []struct {
+		name     string
+		old, new string
+		want     bool
+	}{
+		{"identical", cert, cert, true},
+		{"CRLF line endings", cert, strings.ReplaceAll(cert, "\n", "\r\n"), true},
+		{"surrounding whitespace", cert, "\n  " + cert + "\n\n", true},
+		{"different certificate", cert, other, false},
+		{"new resource", "", cert, false},
+		{"not a certificate", "garbage", cert, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := suppressEquivalentCertificatePEM("certificate", tc.old, tc.new, nil); got != tc.want {
+				t.Errorf("suppressEquivalentCertificatePEM() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSuppressEquivalentCertificateBundlePEM(t *testing.T) {
+	root := testCertificatePEM(t, "Root CA")
+	intermediate := testCertificatePEM(t, "Intermediate CA")
+	other := testCertificatePEM(t, "Other CA")
+	chain := intermediate + root
+
+	cases := []struct {
+		name     string
+		old, new string
+		want     bool
+	}{
+		{"identical", chain, chain, true},
+		{"CRLF line endings", chain, strings.ReplaceAll(chain, "\n", "\r\n"), true},
+		{"whitespace between blocks", chain, "\n" + intermediate + "\n\n  " + root + "\n", true},
+		{"reordered", chain, root + intermediate, true},
+		{"single certificate", root, root, true},
+		{"certificate removed", chain, intermediate, false},
+		{"certificate added", intermediate, chain, false},
+		{"certificate replaced", chain, intermediate + other, false},
+		{"duplicate instead of distinct", chain, root + root, false},
+		{"new resource", "", chain, false},
+		{"not a certificate", "garbage", chain, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := suppressEquivalentCertificateBundlePEM("ca_certificate", tc.old, tc.new, nil); got != tc.want {
+				t.Errorf("suppressEquivalentCertificateBundlePEM() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+// testCertificatePEM returns a PEM-encoded self-signed certificate for
+// commonName.
+func testCertificatePEM(t *testing.T, commonName string) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
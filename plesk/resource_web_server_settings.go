@@ -0,0 +1,236 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceWebServerSettings defines the per-domain Apache and nginx settings resource schema and CRUD operations.
+func ResourceWebServerSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWebServerSettingsCreate,
+		ReadContext:   resourceWebServerSettingsRead,
+		UpdateContext: resourceWebServerSettingsUpdate,
+		DeleteContext: resourceWebServerSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the domain the web server settings apply to.",
+			},
+			"apache_http_directives": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Additional Apache directives for the HTTP virtual host.",
+			},
+			"apache_https_directives": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Additional Apache directives for the HTTPS virtual host.",
+			},
+			"nginx_directives": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Additional nginx directives (e.g., location blocks, headers, rewrites).",
+			},
+			"proxy_mode": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether nginx proxies requests to Apache.",
+			},
+			"nginx_serve_static_files": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether nginx serves static files directly.",
+			},
+			"nginx_static_extensions": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Space-separated list of file extensions served directly by nginx.",
+			},
+			"nginx_cache_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether nginx caching is enabled for the domain.",
+			},
+			"nginx_cache_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum size of the nginx cache in megabytes.",
+			},
+			"nginx_cache_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Time in seconds a cached response is considered valid.",
+			},
+			"browser_cache_expires": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Expiration period for static files cached by browsers (e.g., 24h, 7d, max, off).",
+			},
+		},
+	}
+}
+
+// webServerSettingsBody mirrors the body of the /api/v2/domains/{id}/web-server-settings endpoint.
+type webServerSettingsBody struct {
+	ApacheHTTPDirectives  string `json:"apache_http_directives"`
+	ApacheHTTPSDirectives string `json:"apache_https_directives"`
+	NginxDirectives       string `json:"nginx_directives"`
+	ProxyMode             bool   `json:"proxy_mode"`
+	NginxServeStaticFiles bool   `json:"nginx_serve_static_files"`
+	NginxStaticExtensions string `json:"nginx_static_extensions,omitempty"`
+	NginxCacheEnabled     bool   `json:"nginx_cache_enabled"`
+	NginxCacheSize        int    `json:"nginx_cache_size,omitempty"`
+	NginxCacheTimeout     int    `json:"nginx_cache_timeout,omitempty"`
+	BrowserCacheExpires   string `json:"browser_cache_expires,omitempty"`
+}
+
+func resourceWebServerSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("domain_id").(string))
+
+	if diags := resourceWebServerSettingsPut(ctx, d, m); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceWebServerSettingsRead(ctx, d, m)
+}
+
+func resourceWebServerSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	path := fmt.Sprintf("/api/v2/domains/%s/web-server-settings", d.Id())
+	respBody, diags := client.Get(ctx, path)
+	if diags.HasError() {
+		return diags
+	}
+
+	var resp webServerSettingsBody
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse web server settings response: %s", err)
+	}
+
+	d.Set("domain_id", d.Id())
+	d.Set("apache_http_directives", resp.ApacheHTTPDirectives)
+	d.Set("apache_https_directives", resp.ApacheHTTPSDirectives)
+	d.Set("nginx_directives", resp.NginxDirectives)
+	d.Set("proxy_mode", resp.ProxyMode)
+	d.Set("nginx_serve_static_files", resp.NginxServeStaticFiles)
+	d.Set("nginx_static_extensions", resp.NginxStaticExtensions)
+	d.Set("nginx_cache_enabled", resp.NginxCacheEnabled)
+	d.Set("nginx_cache_size", resp.NginxCacheSize)
+	d.Set("nginx_cache_timeout", resp.NginxCacheTimeout)
+	d.Set("browser_cache_expires", resp.BrowserCacheExpires)
+
+	return nil
+}
+
+func resourceWebServerSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := resourceWebServerSettingsPut(ctx, d, m); diags.HasError() {
+		return diags
+	}
+
+	return resourceWebServerSettingsRead(ctx, d, m)
+}
+
+func resourceWebServerSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	// Clear the custom directives but leave the proxy and caching options as
+	// they are; the domain always has some web server configuration.
+	reqBody := map[string]interface{}{
+		"apache_http_directives":  "",
+		"apache_https_directives": "",
+		"nginx_directives":        "",
+	}
+
+	path := fmt.Sprintf("/api/v2/domains/%s/web-server-settings", d.Id())
+	if _, diags := client.Put(ctx, path, reqBody); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceWebServerSettingsPut(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	reqBody := webServerSettingsBody{
+		ApacheHTTPDirectives:  d.Get("apache_http_directives").(string),
+		ApacheHTTPSDirectives: d.Get("apache_https_directives").(string),
+		NginxDirectives:       d.Get("nginx_directives").(string),
+		ProxyMode:             d.Get("proxy_mode").(bool),
+		NginxServeStaticFiles: d.Get("nginx_serve_static_files").(bool),
+		NginxCacheEnabled:     d.Get("nginx_cache_enabled").(bool),
+	}
+	if v, ok := d.GetOk("nginx_static_extensions"); ok {
+		reqBody.NginxStaticExtensions = v.(string)
+	}
+	if v, ok := d.GetOk("nginx_cache_size"); ok {
+		reqBody.NginxCacheSize = v.(int)
+	}
+	if v, ok := d.GetOk("nginx_cache_timeout"); ok {
+		reqBody.NginxCacheTimeout = v.(int)
+	}
+	if v, ok := d.GetOk("browser_cache_expires"); ok {
+		reqBody.BrowserCacheExpires = v.(string)
+	}
+
+	path := fmt.Sprintf("/api/v2/domains/%s/web-server-settings", d.Id())
+	respBody, diags := client.Put(ctx, path, reqBody)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Plesk tests the resulting Apache and nginx configuration before applying
+	// it and reports any rejected directives in the response body.
+	if len(respBody) == 0 {
+		return nil
+	}
+
+	var resp struct {
+		Errors []struct {
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return diag.Errorf("failed to parse web server settings update response: %s", err)
+	}
+
+	for _, e := range resp.Errors {
+		validationDiag := diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Plesk rejected the web server configuration",
+			Detail:   e.Message,
+		}
+		if e.Field != "" {
+			validationDiag.AttributePath = cty.GetAttrPath(e.Field)
+		}
+		diags = append(diags, validationDiag)
+	}
+
+	return diags
+}
@@ -0,0 +1,15 @@
+package plesk
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// expandStringSet converts a set of strings into a string slice.
+func expandStringSet(set *schema.Set) []string {
+	values := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		values = append(values, v.(string))
+	}
+
+	return values
+}
@@ -0,0 +1,200 @@
+package plesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// asyncOperation describes a long-running Plesk operation whose state is
+// reported by a status endpoint, such as a task, an extension installation
+// or a certificate issuance.
+type asyncOperation struct {
+	// Description names the operation in logs and errors, e.g.
+	// "installation of extension firewall".
+	Description string
+	// Path is the status endpoint polled with GET.
+	Path string
+
+	Pending []string
+	Target  []string
+	Failed  []string
+	// Stale lists statuses the endpoint may still report from before the
+	// operation started, such as "active" right after an apply request.
+	// They are treated as pending until another status has been seen.
+	Stale []string
+
+	// Timeout is usually the resource's d.Timeout for the current operation.
+	Timeout time.Duration
+	// Delay and MinTimeout default to 2 and 5 seconds. The interval between
+	// polls grows from MinTimeout while the status does not change.
+	Delay      time.Duration
+	MinTimeout time.Duration
+}
+
+// asyncStatusNotStarted is the state reported to the poller while only
+// stale statuses, or none at all, have been seen.
+const asyncStatusNotStarted = "not_started"
+
+// asyncMaxTransientErrors is the number of consecutive failed status polls
+// tolerated before waiting for an operation is given up.
+const asyncMaxTransientErrors = 3
+
+// asyncStatus holds the fields shared by all status endpoints.
+type asyncStatus struct {
+	Status   string `json:"status"`
+	Progress int    `json:"progress"`
+	Message  string `json:"message,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// waitForAsyncOperation polls op.Path until the status is one of op.Target or
+// op.Failed, the timeout expires or ctx is cancelled. Progress changes are
+// logged, and up to asyncMaxTransientErrors failed polls in a row are
+// retried. If result is not nil, the last status body is decoded into it,
+// including when the operation failed, so callers can report details.
+func waitForAsyncOperation(ctx context.Context, client *Client, op asyncOperation, result interface{}) diag.Diagnostics {
+	delay, minTimeout := op.Delay, op.MinTimeout
+	if delay == 0 {
+		delay = 2 * time.Second
+	}
+	if minTimeout == 0 {
+		minTimeout = 5 * time.Second
+	}
+
+	var last asyncStatus
+	var lastBody []byte
+	lastState := asyncStatusNotStarted
+	transientErrors := 0
+	started := len(op.Stale) == 0
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    append(append([]string{}, op.Pending...), asyncStatusNotStarted),
+		Target:     append(append([]string{}, op.Target...), op.Failed...),
+		Timeout:    op.Timeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
+		Refresh: func() (interface{}, string, error) {
+			respBody, diags := client.Get(ctx, op.Path)
+			if diags.HasError() {
+				// The operation keeps running on the server, so ride out a
+				// few failed polls, e.g. while the API restarts.
+				transientErrors++
+				if ctx.Err() != nil || transientErrors > asyncMaxTransientErrors {
+					return nil, "", fmt.Errorf("%s", diags[0].Summary)
+				}
+				log.Printf("[WARN] %s: polling status failed (%d/%d): %s", op.Description, transientErrors, asyncMaxTransientErrors, diags[0].Summary)
+				return last, lastState, nil
+			}
+			transientErrors = 0
+
+			var status asyncStatus
+			if err := json.Unmarshal(respBody, &status); err != nil {
+				return nil, "", fmt.Errorf("failed to parse status response: %s", err)
+			}
+
+			if status.Status != last.Status || status.Progress != last.Progress || status.Message != last.Message {
+				log.Printf("[DEBUG] %s: %s (%d%%) %s", op.Description, status.Status, status.Progress, status.Message)
+			}
+			last, lastBody = status, respBody
+
+			if !started {
+				if containsString(op.Stale, status.Status) {
+					return status, asyncStatusNotStarted, nil
+				}
+				started = true
+			}
+			lastState = status.Status
+
+			return status, status.Status, nil
+		},
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	if result != nil && lastBody != nil {
+		if uerr := json.Unmarshal(lastBody, result); uerr != nil {
+			return diag.Errorf("failed to parse status response of %s: %s", op.Description, uerr)
+		}
+	}
+
+	if err != nil {
+		if ctx.Err() != nil {
+			return diag.Errorf("%s was cancelled while %s (%d%%): %s", op.Description, last.Status, last.Progress, ctx.Err())
+		}
+		return diag.Errorf("error waiting for %s (last status %q, %d%%): %s", op.Description, last.Status, last.Progress, err)
+	}
+
+	for _, failed := range op.Failed {
+		if last.Status == failed {
+			detail := last.Error
+			if detail == "" {
+				detail = last.Message
+			}
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("%s failed", op.Description),
+				Detail:   detail,
+			}}
+		}
+	}
+
+	return nil
+}
+
+// pleskTask mirrors the body of GET /api/v2/tasks/{id}, which reports the
+// state of a background operation such as a backup restore.
+type pleskTask struct {
+	ID        string             `json:"id"`
+	Status    string             `json:"status"`
+	Progress  int                `json:"progress"`
+	Message   string             `json:"message,omitempty"`
+	Error     string             `json:"error,omitempty"`
+	Conflicts []pleskTaskProblem `json:"conflicts,omitempty"`
+}
+
+// pleskTaskProblem is a conflict or error Plesk reported for one object
+// handled by a task.
+type pleskTaskProblem struct {
+	Object  string `json:"object"`
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// waitForTask polls a Plesk task until it completes, fails or the timeout
+// expires. description names the operation the task performs in logs and
+// errors. A failed task is returned along with an error so that callers can
+// report its conflicts.
+func waitForTask(ctx context.Context, client *Client, taskID, description string, timeout time.Duration) (*pleskTask, diag.Diagnostics) {
+	var task pleskTask
+
+	diags := waitForAsyncOperation(ctx, client, asyncOperation{
+		Description: fmt.Sprintf("%s (task %s)", description, taskID),
+		Path:        fmt.Sprintf("/api/v2/tasks/%s", taskID),
+		Pending:     []string{"queued", "running"},
+		Target:      []string{"completed"},
+		Failed:      []string{"failed"},
+		Timeout:     timeout,
+	}, &task)
+
+	if task.Status == "" {
+		return nil, diags
+	}
+
+	return &task, diags
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,205 @@
+package plesk
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testResponse is a canned response of a status endpoint.
+type testResponse struct {
+	code int
+	body string
+}
+
+// newResponseSequenceClient returns a Client whose endpoints answer with the
+// given responses in turn, repeating the last one.
+func newResponseSequenceClient(t *testing.T, responses ...testResponse) *Client {
+	t.Helper()
+
+	var mu sync.Mutex
+	polls := 0
+
+	return newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		resp := responses[len(responses)-1]
+		if polls < len(responses) {
+			resp = responses[polls]
+		}
+		polls++
+
+		w.WriteHeader(resp.code)
+		w.Write([]byte(resp.body))
+	})
+}
+
+// newStatusSequenceClient returns a Client whose status endpoint reports
+// the given statuses in turn, repeating the last one.
+func newStatusSequenceClient(t *testing.T, statuses ...string) *Client {
+	t.Helper()
+
+	responses := make([]testResponse, 0, len(statuses))
+	for _, status := range statuses {
+		responses = append(responses, testResponse{http.StatusOK, `{"status":"` + status + `"}`})
+	}
+
+	return newResponseSequenceClient(t, responses...)
+}
+
+// testTaskOperation returns the asyncOperation waitForTask uses, polling
+// quickly and giving up after timeout.
+func testTaskOperation(timeout time.Duration) asyncOperation {
+	return asyncOperation{
+		Description: "test task",
+		Path:        "/api/v2/tasks/1",
+		Pending:     []string{"queued", "running"},
+		Target:      []string{"completed"},
+		Failed:      []string{"failed"},
+		Timeout:     timeout,
+		Delay:       time.Millisecond,
+		MinTimeout:  time.Millisecond,
+	}
+}
+
+func TestWaitForAsyncOperation(t *testing.T) {
+	cases := []struct {
+		name      string
+		responses []testResponse
+		timeout   time.Duration
+		wantErr   string
+		want      pleskTask
+	}{
+		{
+			name: "target reached",
+			responses: []testResponse{
+				{http.StatusOK, `{"id":"1","status":"queued"}`},
+				{http.StatusOK, `{"id":"1","status":"running","progress":50}`},
+				{http.StatusOK, `{"id":"1","status":"completed","progress":100}`},
+			},
+			want: pleskTask{ID: "1", Status: "completed", Progress: 100},
+		},
+		{
+			name: "failed operation",
+			responses: []testResponse{
+				{http.StatusOK, `{"id":"1","status":"running"}`},
+				{http.StatusOK, `{"id":"1","status":"failed","error":"disk full","conflicts":[{"object":"example.com","type":"domain","message":"exists"}]}`},
+			},
+			wantErr: "test task failed",
+			want: pleskTask{ID: "1", Status: "failed", Error: "disk full", Conflicts: []pleskTaskProblem{
+				{Object: "example.com", Type: "domain", Message: "exists"},
+			}},
+		},
+		{
+			name:      "timeout",
+			responses: []testResponse{{http.StatusOK, `{"id":"1","status":"running","progress":10}`}},
+			timeout:   300 * time.Millisecond,
+			wantErr:   `error waiting for test task (last status "running", 10%)`,
+			want:      pleskTask{ID: "1", Status: "running", Progress: 10},
+		},
+		{
+			name: "transient errors are retried",
+			responses: []testResponse{
+				{http.StatusOK, `{"id":"1","status":"running"}`},
+				{http.StatusBadGateway, `restarting`},
+				{http.StatusServiceUnavailable, `restarting`},
+				{http.StatusOK, `{"id":"1","status":"completed"}`},
+			},
+			want: pleskTask{ID: "1", Status: "completed"},
+		},
+		{
+			name: "persistent errors",
+			responses: []testResponse{
+				{http.StatusOK, `{"id":"1","status":"running"}`},
+				{http.StatusInternalServerError, `boom`},
+			},
+			wantErr: "HTTP 500",
+			want:    pleskTask{ID: "1", Status: "running"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newResponseSequenceClient(t, tc.responses...)
+
+			timeout := tc.timeout
+			if timeout == 0 {
+				timeout = 30 * time.Second
+			}
+
+			var task pleskTask
+			diags := waitForAsyncOperation(context.Background(), client, testTaskOperation(timeout), &task)
+
+			if tc.wantErr == "" && diags.HasError() {
+				t.Fatalf("waitForAsyncOperation() diags = %v", diags)
+			}
+			if tc.wantErr != "" && (!diags.HasError() || !strings.Contains(diags[0].Summary, tc.wantErr)) {
+				t.Fatalf("waitForAsyncOperation() diags = %v, want %q", diags, tc.wantErr)
+			}
+			if !reflect.DeepEqual(task, tc.want) {
+				t.Errorf("result = %+v, want %+v", task, tc.want)
+			}
+		})
+	}
+}
+
+func TestWaitForAsyncOperationCancelled(t *testing.T) {
+	client := newStatusSequenceClient(t, "running")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	diags := waitForAsyncOperation(ctx, client, testTaskOperation(30*time.Second), nil)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "was cancelled while running") {
+		t.Fatalf("waitForAsyncOperation() diags = %v, want a cancellation error", diags)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("waitForAsyncOperation() returned after %s, want it to stop on cancellation", elapsed)
+	}
+}
+
+func TestWaitForAsyncOperationStale(t *testing.T) {
+	cases := []struct {
+		name       string
+		statuses   []string
+		wantStatus string
+		wantErr    bool
+	}{
+		{"stale active before apply", []string{"active", "active", "applying", "awaiting_confirmation"}, "awaiting_confirmation", false},
+		{"apply already seen", []string{"applying", "active"}, "active", false},
+		{"stale failure before apply", []string{"rolled_back", "applying", "awaiting_confirmation"}, "awaiting_confirmation", false},
+		{"failure after apply", []string{"active", "applying", "rolled_back"}, "rolled_back", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newStatusSequenceClient(t, tc.statuses...)
+
+			var status asyncStatus
+			diags := waitForAsyncOperation(context.Background(), client, asyncOperation{
+				Description: "firewall configuration apply",
+				Path:        "/api/v2/extensions/firewall/status",
+				Pending:     []string{"applying"},
+				Target:      []string{"awaiting_confirmation", "active"},
+				Failed:      []string{"failed", "rolled_back"},
+				Stale:       []string{"active", "failed", "rolled_back"},
+				Timeout:     5 * time.Second,
+				Delay:       time.Millisecond,
+				MinTimeout:  time.Millisecond,
+			}, &status)
+
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("waitForAsyncOperation() diags = %v, wantErr %v", diags, tc.wantErr)
+			}
+			if status.Status != tc.wantStatus {
+				t.Errorf("status = %q, want %q", status.Status, tc.wantStatus)
+			}
+		})
+	}
+}
@@ -35,18 +35,51 @@ func Provider() *schema.Provider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"plesk_site":          plesk.ResourceSite(),
-			"plesk_ftp_account":   plesk.ResourceFTPAccount(),
-			"plesk_user":          plesk.ResourceUser(),
-			"plesk_reseller":      plesk.ResourceReseller(),
-			"plesk_mailbox":       plesk.ResourceMailbox(),
-			"plesk_database":      plesk.ResourceDatabase(),
-			"plesk_database_user": plesk.ResourceDatabaseUser(),
-			"plesk_extension":     plesk.ResourceExtension(),
-			"plesk_dns_record":    plesk.ResourceDnsRecord(),
+			"plesk_site":                    plesk.ResourceSite(),
+			"plesk_ftp_account":             plesk.ResourceFTPAccount(),
+			"plesk_user":                    plesk.ResourceUser(),
+			"plesk_reseller":                plesk.ResourceReseller(),
+			"plesk_mailbox":                 plesk.ResourceMailbox(),
+			"plesk_database":                plesk.ResourceDatabase(),
+			"plesk_database_user":           plesk.ResourceDatabaseUser(),
+			"plesk_extension":               plesk.ResourceExtension(),
+			"plesk_dns_record":              plesk.ResourceDnsRecord(),
+			"plesk_php_settings":            plesk.ResourcePHPSettings(),
+			"plesk_web_server_settings":     plesk.ResourceWebServerSettings(),
+			"plesk_ssl_certificate":         plesk.ResourceSSLCertificate(),
+			"plesk_ssl_certificate_binding": plesk.ResourceSSLCertificateBinding(),
+			"plesk_letsencrypt_certificate": plesk.ResourceLetsEncryptCertificate(),
+			"plesk_mail_settings":           plesk.ResourceMailSettings(),
+			"plesk_mail_forwarder":          plesk.ResourceMailForwarder(),
+			"plesk_mail_alias":              plesk.ResourceMailAlias(),
+			"plesk_mail_autoresponder":      plesk.ResourceMailAutoresponder(),
+			"plesk_spam_filter":             plesk.ResourceSpamFilter(),
+			"plesk_mailing_list":            plesk.ResourceMailingList(),
+			"plesk_dns_zone":                plesk.ResourceDnsZone(),
+			"plesk_dns_zone_settings":       plesk.ResourceDnsZoneSettings(),
+			"plesk_dns_template_record":     plesk.ResourceDnsTemplateRecord(),
+			"plesk_dns_template_apply":      plesk.ResourceDnsTemplateApply(),
+			"plesk_mail_authentication":     plesk.ResourceMailAuthentication(),
+			"plesk_database_server":         plesk.ResourceDatabaseServer(),
+			"plesk_database_import":         plesk.ResourceDatabaseImport(),
+			"plesk_database_export":         plesk.ResourceDatabaseExport(),
+			"plesk_scheduled_task":          plesk.ResourceScheduledTask(),
+			"plesk_backup":                  plesk.ResourceBackup(),
+			"plesk_backup_schedule":         plesk.ResourceBackupSchedule(),
+			"plesk_backup_storage_ftp":      plesk.ResourceBackupStorageFTP(),
+			"plesk_backup_storage_s3":       plesk.ResourceBackupStorageS3(),
+			"plesk_backup_restore":          plesk.ResourceBackupRestore(),
+			"plesk_firewall":                plesk.ResourceFirewall(),
+			"plesk_firewall_rule":           plesk.ResourceFirewallRule(),
+			"plesk_fail2ban_settings":       plesk.ResourceFail2banSettings(),
+			"plesk_fail2ban_jail":           plesk.ResourceFail2banJail(),
+			"plesk_fail2ban_trusted_ip":     plesk.ResourceFail2banTrustedIP(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"plesk_domains": plesk.DataSourceDomains(),
+			"plesk_domains":          plesk.DataSourceDomains(),
+			"plesk_php_handlers":     plesk.DataSourcePHPHandlers(),
+			"plesk_database_servers": plesk.DataSourceDatabaseServers(),
+			"plesk_backups":          plesk.DataSourceBackups(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}